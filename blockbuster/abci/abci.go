@@ -0,0 +1,121 @@
+package abci
+
+import (
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+)
+
+// ProposalHandler wraps the chain of lanes that make up a block-space
+// partitioned mempool and exposes the PrepareProposal/ProcessProposal ABCI
+// handlers that the application wires into baseapp.
+type ProposalHandler struct {
+	logger log.Logger
+	lanes  []blockbuster.Lane
+}
+
+// NewProposalHandler returns a ProposalHandler that chains together the
+// given lanes, in order. The first lane gets first pick of the transactions
+// in a proposal; anything it does not claim is left for the next lane.
+func NewProposalHandler(logger log.Logger, lanes ...blockbuster.Lane) *ProposalHandler {
+	return &ProposalHandler{
+		logger: logger,
+		lanes:  lanes,
+	}
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler that asks each
+// lane, in order, to fill a single evolving blockbuster.Proposal with the
+// transactions it wants included. Each lane only ever sees the block space
+// left over by the lanes that ran before it.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		proposal := blockbuster.NewProposal(req.MaxTxBytes)
+		for _, lane := range h.lanes {
+			proposal.LaneLimits[lane.Name()] = lane.GetMaxBlockSpace()
+		}
+
+		for _, lane := range h.lanes {
+			txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(ctx, proposal)
+			if err != nil {
+				h.logger.Error("failed to prepare lane", "lane", lane.Name(), "err", err)
+				continue
+			}
+
+			for _, txBz := range txsToExclude {
+				tx, err := lane.TxDecoder()(txBz)
+				if err != nil {
+					continue
+				}
+
+				if err := lane.Remove(tx); err != nil {
+					h.logger.Error("failed to remove invalid tx from lane mempool", "lane", lane.Name(), "err", err)
+				}
+			}
+
+			updated, err := proposal.UpdateProposal(lane.Name(), txsToInclude, sumLens(txsToInclude))
+			if err != nil {
+				h.logger.Error("failed to update proposal", "lane", lane.Name(), "err", err)
+				continue
+			}
+
+			proposal = updated
+		}
+
+		return &abci.ResponsePrepareProposal{Txs: proposal.Txs}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler that chains the
+// lanes over the proposal's transactions exactly once each. Each lane's
+// ProcessLaneHandler claims the prefix of the remaining transactions that
+// belongs to it and folds that claim into the running blockbuster.Proposal;
+// whatever is left over is handed to the next lane. A lane therefore no
+// longer needs a second, order-checking pass over the proposal.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		txs := make([]sdk.Tx, 0, len(req.Txs))
+		for _, txBz := range req.Txs {
+			tx, err := h.lanes[0].TxDecoder()(txBz)
+			if err != nil {
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
+			}
+
+			txs = append(txs, tx)
+		}
+
+		proposal := blockbuster.NewProposal(sumLens(req.Txs))
+
+		remaining := txs
+		for _, lane := range h.lanes {
+			var (
+				updated blockbuster.Proposal
+				err     error
+			)
+
+			remaining, updated, err = lane.ProcessLaneHandler()(ctx, proposal, remaining)
+			if err != nil {
+				h.logger.Error("lane rejected proposal", "lane", lane.Name(), "err", err)
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, err
+			}
+
+			proposal = updated
+		}
+
+		if len(remaining) > 0 {
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+func sumLens(txs [][]byte) int64 {
+	var total int64
+	for _, tx := range txs {
+		total += int64(len(tx))
+	}
+
+	return total
+}
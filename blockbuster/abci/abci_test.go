@@ -0,0 +1,131 @@
+package abci_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/abci"
+	"github.com/skip-mev/pob/blockbuster/lanes/constructor"
+	"github.com/skip-mev/pob/x/builder/lanes/mev"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// testTx is a minimal sdk.Tx fixture identified by id.
+type testTx struct {
+	id string
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return nil }
+
+// fakeMempool is a minimal, unordered blockbuster.LaneMempool backed by a
+// slice, sufficient for exercising a lane's handlers in isolation.
+type fakeMempool struct {
+	txs []sdk.Tx
+}
+
+func (m *fakeMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	m.txs = append(m.txs, tx)
+	return nil
+}
+
+func (m *fakeMempool) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
+	if len(m.txs) == 0 {
+		return nil
+	}
+
+	return &fakeIterator{txs: m.txs, index: 0}
+}
+
+func (m *fakeMempool) CountTx() int { return len(m.txs) }
+
+func (m *fakeMempool) Remove(_ sdk.Tx) error { return nil }
+
+func (m *fakeMempool) Contains(_ sdk.Tx) bool { return false }
+
+type fakeIterator struct {
+	txs   []sdk.Tx
+	index int
+}
+
+func (it *fakeIterator) Tx() sdk.Tx { return it.txs[it.index] }
+
+func (it *fakeIterator) Next() sdkmempool.Iterator {
+	if it.index+1 >= len(it.txs) {
+		return nil
+	}
+
+	return &fakeIterator{txs: it.txs, index: it.index + 1}
+}
+
+func TestPrepareProposalHandlerFillsProposalFromLaneMempool(t *testing.T) {
+	cfg := blockbuster.BaseLaneConfig{
+		Logger:        log.NewNopLogger(),
+		TxEncoder:     func(tx sdk.Tx) ([]byte, error) { return []byte(tx.(testTx).id), nil },
+		TxDecoder:     func(txBz []byte) (sdk.Tx, error) { return testTx{id: string(txBz)}, nil },
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+
+	mempool := &fakeMempool{txs: []sdk.Tx{testTx{id: "a"}, testTx{id: "b"}}}
+	lane := constructor.NewLaneConstructor[string](cfg, "test", mempool, func(_ sdk.Context, _ sdk.Tx) bool { return true })
+
+	handler := abci.NewProposalHandler(log.NewNopLogger(), lane)
+
+	resp, err := handler.PrepareProposalHandler()(sdk.Context{}, &abcitypes.RequestPrepareProposal{MaxTxBytes: 1024})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, resp.Txs,
+		"PrepareProposalHandler must populate each lane's LaneLimits, or DefaultPrepareLaneHandler rejects every transaction")
+}
+
+// bidTx is a minimal sdk.Tx fixture carrying a single MsgAuctionBid,
+// identified by id.
+type bidTx struct {
+	id  string
+	bid *types.MsgAuctionBid
+}
+
+func (tx bidTx) GetMsgs() []sdk.Msg { return []sdk.Msg{tx.bid} }
+
+// workingSignerExtractor extracts a fixed signer for every transaction.
+type workingSignerExtractor struct{}
+
+func (workingSignerExtractor) GetSigners(_ sdk.Tx) ([]blockbuster.SignerData, error) {
+	return []blockbuster.SignerData{{Signer: sdk.AccAddress([]byte("bundled_signer______"))}}, nil
+}
+
+func TestPrepareProposalHandlerSelectsMevBid(t *testing.T) {
+	cfg := blockbuster.BaseLaneConfig{
+		Logger:          log.NewNopLogger(),
+		TxEncoder:       func(tx sdk.Tx) ([]byte, error) { return []byte(tx.(bidTx).id), nil },
+		TxDecoder:       func(txBz []byte) (sdk.Tx, error) { return bidTx{id: string(txBz)}, nil },
+		MaxBlockSpace:   math.LegacyOneDec(),
+		SignerExtractor: workingSignerExtractor{},
+	}
+
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+	bid := bidTx{
+		id: "bid",
+		bid: types.NewMsgAuctionBid(
+			bidder,
+			sdk.NewInt64Coin("uatom", 100),
+			types.NewLegacyBundle([][]byte{[]byte("bundled-tx")}),
+		),
+	}
+
+	mempool := &fakeMempool{txs: []sdk.Tx{bid}}
+	lane := mev.NewLane(cfg, mempool)
+
+	handler := abci.NewProposalHandler(log.NewNopLogger(), lane)
+
+	resp, err := handler.PrepareProposalHandler()(sdk.Context{}, &abcitypes.RequestPrepareProposal{MaxTxBytes: 1024})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("bid"), []byte("bundled-tx")}, resp.Txs,
+		"PrepareProposalHandler must populate each lane's LaneLimits, or the MEV lane's limit check always rejects the bid")
+}
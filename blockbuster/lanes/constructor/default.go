@@ -0,0 +1,115 @@
+package constructor
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+)
+
+// DefaultPrepareLaneHandler returns a default implementation of the
+// PrepareLaneHandler. It fills the proposal with transactions from the
+// lane's mempool, in mempool order, until the lane's allotted block space
+// (derived from the running blockbuster.Proposal) is exhausted. Only bytes
+// are counted; gas is not tracked or limited. Transactions that fail
+// verification are returned for exclusion from the mempool; transactions
+// that fail to encode are skipped and are neither included nor excluded.
+func (l *LaneConstructor[C]) DefaultPrepareLaneHandler() blockbuster.PrepareLaneHandler {
+	return func(ctx sdk.Context, proposal blockbuster.Proposal) ([][]byte, [][]byte, error) {
+		var (
+			txsToInclude [][]byte
+			txsToExclude [][]byte
+		)
+
+		limit := proposal.LaneLimit(l.Name())
+
+		iterator := l.Select(ctx, nil)
+		for iterator != nil {
+			tx := iterator.Tx()
+
+			if err := l.VerifyTx(ctx, tx); err != nil {
+				if txBz, encErr := l.encode(tx); encErr == nil {
+					txsToExclude = append(txsToExclude, txBz)
+				}
+				iterator = iterator.Next()
+				continue
+			}
+
+			txBz, err := l.encode(tx)
+			if err != nil {
+				iterator = iterator.Next()
+				continue
+			}
+
+			if int64(len(txBz)) > limit-sumLens(txsToInclude) {
+				break
+			}
+
+			txsToInclude = append(txsToInclude, txBz)
+			iterator = iterator.Next()
+		}
+
+		return txsToInclude, txsToExclude, nil
+	}
+}
+
+// DefaultProcessLaneHandler returns a default implementation of the
+// ProcessLaneHandler. It walks the prefix of the remaining transactions
+// that match this lane, verifies each of them, and claims that prefix for
+// the lane by folding it into the running blockbuster.Proposal. The first
+// transaction that does not match the lane ends the lane's claim; everything
+// from that point on (including transactions further down that do match) is
+// left for the next lane to consider, which is what makes explicit ordering
+// checks unnecessary.
+func (l *LaneConstructor[C]) DefaultProcessLaneHandler() blockbuster.ProcessLaneHandler {
+	return func(ctx sdk.Context, proposal blockbuster.Proposal, txs []sdk.Tx) ([]sdk.Tx, blockbuster.Proposal, error) {
+		if len(txs) == 0 || proposal.LaneHasSeen(l.Name()) {
+			return txs, proposal, nil
+		}
+
+		var (
+			claimed    [][]byte
+			totalBytes int64
+		)
+
+		index := 0
+		for ; index < len(txs); index++ {
+			tx := txs[index]
+			if !l.Match(ctx, tx) {
+				break
+			}
+
+			if err := l.VerifyTx(ctx, tx); err != nil {
+				return txs, proposal, err
+			}
+
+			txBz, err := l.encode(tx)
+			if err != nil {
+				return txs, proposal, fmt.Errorf("%s lane: failed to encode transaction at index %d: %w", l.Name(), index, err)
+			}
+
+			claimed = append(claimed, txBz)
+			totalBytes += int64(len(txBz))
+		}
+
+		updatedProposal, err := proposal.UpdateProposal(l.Name(), claimed, totalBytes)
+		if err != nil {
+			return txs, proposal, err
+		}
+
+		return txs[index:], updatedProposal, nil
+	}
+}
+
+func (l *LaneConstructor[C]) encode(tx sdk.Tx) ([]byte, error) {
+	return l.TxEncoder()(tx)
+}
+
+func sumLens(txs [][]byte) int64 {
+	var total int64
+	for _, tx := range txs {
+		total += int64(len(tx))
+	}
+
+	return total
+}
@@ -0,0 +1,149 @@
+package constructor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/constructor"
+)
+
+// testTx is a minimal sdk.Tx fixture identified by id.
+type testTx struct {
+	id string
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return nil }
+
+// fakeMempool is a minimal, unordered blockbuster.LaneMempool backed by a
+// slice, sufficient for exercising a lane's handlers in isolation.
+type fakeMempool struct {
+	txs []sdk.Tx
+}
+
+func (m *fakeMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	m.txs = append(m.txs, tx)
+	return nil
+}
+
+func (m *fakeMempool) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
+	if len(m.txs) == 0 {
+		return nil
+	}
+
+	return &fakeIterator{txs: m.txs, index: 0}
+}
+
+func (m *fakeMempool) CountTx() int { return len(m.txs) }
+
+func (m *fakeMempool) Remove(_ sdk.Tx) error { return nil }
+
+func (m *fakeMempool) Contains(_ sdk.Tx) bool { return false }
+
+type fakeIterator struct {
+	txs   []sdk.Tx
+	index int
+}
+
+func (it *fakeIterator) Tx() sdk.Tx { return it.txs[it.index] }
+
+func (it *fakeIterator) Next() sdkmempool.Iterator {
+	if it.index+1 >= len(it.txs) {
+		return nil
+	}
+
+	return &fakeIterator{txs: it.txs, index: it.index + 1}
+}
+
+// encoderFor returns a TxEncoder that fails for every id in failIDs and
+// otherwise encodes a testTx as its id.
+func encoderFor(failIDs map[string]bool) sdk.TxEncoder {
+	return func(tx sdk.Tx) ([]byte, error) {
+		id := tx.(testTx).id
+		if failIDs[id] {
+			return nil, errors.New("encode failed")
+		}
+
+		return []byte(id), nil
+	}
+}
+
+func decoder() sdk.TxDecoder {
+	return func(txBz []byte) (sdk.Tx, error) { return testTx{id: string(txBz)}, nil }
+}
+
+func matchAll() blockbuster.MatchHandler {
+	return func(_ sdk.Context, _ sdk.Tx) bool { return true }
+}
+
+func newTestLane(t *testing.T, mempool blockbuster.LaneMempool, encoder sdk.TxEncoder) *constructor.LaneConstructor[string] {
+	t.Helper()
+
+	cfg := blockbuster.BaseLaneConfig{
+		Logger:        log.NewNopLogger(),
+		TxEncoder:     encoder,
+		TxDecoder:     decoder(),
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+
+	return constructor.NewLaneConstructor[string](cfg, "test", mempool, matchAll())
+}
+
+func TestDefaultPrepareLaneHandler(t *testing.T) {
+	t.Run("fills the proposal with mempool txs up to the lane's limit", func(t *testing.T) {
+		mempool := &fakeMempool{txs: []sdk.Tx{testTx{id: "a"}, testTx{id: "b"}}}
+		lane := newTestLane(t, mempool, encoderFor(nil))
+
+		proposal := blockbuster.NewProposal(1024)
+		proposal.LaneLimits[lane.Name()] = math.LegacyOneDec()
+
+		txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(sdk.Context{}, proposal)
+		require.NoError(t, err)
+		require.Empty(t, txsToExclude)
+		require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, txsToInclude)
+	})
+
+	t.Run("excludes a transaction that fails to encode instead of including a bogus entry", func(t *testing.T) {
+		mempool := &fakeMempool{txs: []sdk.Tx{testTx{id: "a"}, testTx{id: "bad"}}}
+		lane := newTestLane(t, mempool, encoderFor(map[string]bool{"bad": true}))
+
+		proposal := blockbuster.NewProposal(1024)
+		proposal.LaneLimits[lane.Name()] = math.LegacyOneDec()
+
+		txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(sdk.Context{}, proposal)
+		require.NoError(t, err)
+		require.Empty(t, txsToExclude)
+		require.Equal(t, [][]byte{[]byte("a")}, txsToInclude)
+	})
+}
+
+func TestDefaultProcessLaneHandler(t *testing.T) {
+	t.Run("claims the prefix of matching transactions", func(t *testing.T) {
+		lane := newTestLane(t, &fakeMempool{}, encoderFor(nil))
+
+		proposal := blockbuster.NewProposal(1024)
+		txs := []sdk.Tx{testTx{id: "a"}, testTx{id: "b"}}
+
+		remaining, updated, err := lane.ProcessLaneHandler()(sdk.Context{}, proposal, txs)
+		require.NoError(t, err)
+		require.Empty(t, remaining)
+		require.Equal(t, [][]byte{[]byte("a"), []byte("b")}, updated.Txs)
+	})
+
+	t.Run("rejects the proposal if a claimed transaction fails to encode", func(t *testing.T) {
+		lane := newTestLane(t, &fakeMempool{}, encoderFor(map[string]bool{"bad": true}))
+
+		proposal := blockbuster.NewProposal(1024)
+		txs := []sdk.Tx{testTx{id: "bad"}}
+
+		_, _, err := lane.ProcessLaneHandler()(sdk.Context{}, proposal, txs)
+		require.Error(t, err)
+	})
+}
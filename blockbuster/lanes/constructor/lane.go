@@ -11,9 +11,10 @@ import (
 
 // LaneConstructor is a generic implementation of a lane. It is meant to be used
 // as a base for other lanes to be built on top of. It provides a default
-// implementation of the MatchHandler, PrepareLaneHandler, ProcessLaneHandler,
-// and CheckOrderHandler. To extend this lane, you must either utilize the default
-// handlers or construct your own that you pass into the constructor.
+// implementation of the PrepareLaneHandler and ProcessLaneHandler; the
+// MatchHandler has no default and must be passed to NewLaneConstructor.
+// To extend this lane, you must either utilize the default handlers or construct
+// your own that you pass into the constructor.
 type LaneConstructor[C comparable] struct {
 	// cfg stores functionality requred to encode/decode transactions, maintains how
 	// many transactions are allowed in this lane's mempool, and the amount of block
@@ -35,14 +36,17 @@ type LaneConstructor[C comparable] struct {
 	// requested and the lane needs to submit transactions it wants included in the block.
 	prepareLaneHandler blockbuster.PrepareLaneHandler
 
-	// checkOrderHandler is the function that is called when a new proposal is being
-	// verified and the lane needs to verify that the transactions included in the proposal
-	// respect the ordering rules of the lane and does not include transactions from other lanes.
+	// checkOrderHandler is no longer called by the ABCI proposal handlers.
+	//
+	// Deprecated: use processLaneHandler, which enforces ordering implicitly
+	// by only claiming a prefix of the transactions it is handed.
 	checkOrderHandler blockbuster.CheckOrderHandler
 
 	// processLaneHandler is the function that is called when a new proposal is being
-	// verified and the lane needs to verify that the transactions included in the proposal
-	// are valid respecting the verification logic of the lane.
+	// verified and the lane needs to verify that the transactions belonging to it are
+	// valid. It consumes and returns the running blockbuster.Proposal so that ordering
+	// and the proposal's total size are enforced across the whole chain of lanes;
+	// the per-lane limits in Proposal.LaneLimits are not checked here.
 	processLaneHandler blockbuster.ProcessLaneHandler
 }
 
@@ -88,6 +92,10 @@ func (l *LaneConstructor[C]) ValidateBasic() error {
 		return fmt.Errorf("match handler cannot be nil")
 	}
 
+	if l.cfg.SignerExtractor == nil {
+		l.cfg.SignerExtractor = blockbuster.NewDefaultSignerExtractionAdapter()
+	}
+
 	if l.prepareLaneHandler == nil {
 		l.prepareLaneHandler = l.DefaultPrepareLaneHandler()
 	}
@@ -96,10 +104,6 @@ func (l *LaneConstructor[C]) ValidateBasic() error {
 		l.processLaneHandler = l.DefaultProcessLaneHandler()
 	}
 
-	if l.checkOrderHandler == nil {
-		l.checkOrderHandler = l.DefaultCheckOrderHandler()
-	}
-
 	return nil
 }
 
@@ -118,14 +122,36 @@ func (l *LaneConstructor[C]) SetProcessLaneHandler(processLaneHandler blockbuste
 	l.processLaneHandler = processLaneHandler
 }
 
-// SetCheckOrderHandler sets the check order handler for the lane. This handler
-// is called when a new proposal is being verified and the lane needs to verify
-// that the transactions included in the proposal respect the ordering rules of
-// the lane and does not include transactions from other lanes.
+// SetCheckOrderHandler sets the check order handler for the lane.
+//
+// Deprecated: the ABCI proposal handlers no longer call the check order
+// handler. ProcessLaneHandler now enforces ordering by only claiming a
+// prefix of the transactions it is handed, via the threaded blockbuster.Proposal.
 func (l *LaneConstructor[C]) SetCheckOrderHandler(checkOrderHandler blockbuster.CheckOrderHandler) {
 	l.checkOrderHandler = checkOrderHandler
 }
 
+// PrepareLaneHandler returns the prepare lane handler for the lane.
+func (l *LaneConstructor[C]) PrepareLaneHandler() blockbuster.PrepareLaneHandler {
+	return l.prepareLaneHandler
+}
+
+// ProcessLaneHandler returns the process lane handler for the lane.
+func (l *LaneConstructor[C]) ProcessLaneHandler() blockbuster.ProcessLaneHandler {
+	return l.processLaneHandler
+}
+
+// VerifyTx verifies that a transaction respects the lane's ante handler. A
+// lane without an ante handler configured accepts every transaction.
+func (l *LaneConstructor[C]) VerifyTx(ctx sdk.Context, tx sdk.Tx) error {
+	if l.cfg.AnteHandler == nil {
+		return nil
+	}
+
+	_, err := l.cfg.AnteHandler(ctx, tx, ctx.IsCheckTx())
+	return err
+}
+
 // Match returns true if the transaction should be processed by this lane. This
 // function first determines if the transaction matches the lane and then checks
 // if the transaction is on the ignore list. If the transaction is on the ignore
@@ -181,3 +207,9 @@ func (l *LaneConstructor[C]) TxEncoder() sdk.TxEncoder {
 func (l *LaneConstructor[C]) GetMaxBlockSpace() math.LegacyDec {
 	return l.cfg.MaxBlockSpace
 }
+
+// SignerExtractor returns the SignerExtractionAdapter configured for the
+// lane, which handlers should use instead of reaching into sdk.Tx directly.
+func (l *LaneConstructor[C]) SignerExtractor() blockbuster.SignerExtractionAdapter {
+	return l.cfg.SignerExtractor
+}
@@ -0,0 +1,42 @@
+package constructor_test
+
+import (
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/constructor"
+)
+
+func TestVerifyTx(t *testing.T) {
+	cfg := blockbuster.BaseLaneConfig{
+		Logger:        log.NewNopLogger(),
+		TxEncoder:     encoderFor(nil),
+		TxDecoder:     decoder(),
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+
+	t.Run("accepts every transaction when no ante handler is configured", func(t *testing.T) {
+		lane := constructor.NewLaneConstructor[string](cfg, "test", &fakeMempool{}, matchAll())
+		require.NoError(t, lane.VerifyTx(sdk.Context{}, testTx{id: "a"}))
+	})
+
+	t.Run("runs the configured ante handler", func(t *testing.T) {
+		lane := constructor.NewLaneConstructor[string](cfg, "test", &fakeMempool{}, matchAll())
+		lane.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+			if tx.(testTx).id == "bad" {
+				return ctx, errors.New("ante rejected tx")
+			}
+
+			return ctx, nil
+		})
+
+		require.NoError(t, lane.VerifyTx(sdk.Context{}, testTx{id: "good"}))
+		require.Error(t, lane.VerifyTx(sdk.Context{}, testTx{id: "bad"}))
+	})
+}
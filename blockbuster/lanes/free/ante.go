@@ -0,0 +1,50 @@
+package free
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+)
+
+// FreeLaneDecorator skips fee deduction for transactions that match the
+// free lane, while still enforcing that those transactions declare a
+// reasonable gas limit so they cannot be used to grief block space. Any
+// transaction that does not match the lane is delegated to the wrapped
+// decorator unchanged.
+type FreeLaneDecorator struct {
+	lane               blockbuster.Lane
+	deductFeeDecorator sdk.AnteDecorator
+	maxGas             uint64
+}
+
+// NewFreeLaneDecorator returns a new FreeLaneDecorator. deductFeeDecorator
+// is the decorator that would otherwise deduct fees (e.g. the SDK's
+// DeductFeeDecorator); it is only invoked for transactions that do not
+// match the free lane. maxGas is the maximum gas a free transaction may
+// declare.
+func NewFreeLaneDecorator(lane blockbuster.Lane, deductFeeDecorator sdk.AnteDecorator, maxGas uint64) FreeLaneDecorator {
+	return FreeLaneDecorator{
+		lane:               lane,
+		deductFeeDecorator: deductFeeDecorator,
+		maxGas:             maxGas,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d FreeLaneDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !d.lane.Match(ctx, tx) {
+		return d.deductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, fmt.Errorf("free lane transaction must implement sdk.FeeTx")
+	}
+
+	if feeTx.GetGas() > d.maxGas {
+		return ctx, fmt.Errorf("free lane transaction gas limit %d exceeds maximum of %d", feeTx.GetGas(), d.maxGas)
+	}
+
+	return next(ctx, tx, simulate)
+}
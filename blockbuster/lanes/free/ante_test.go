@@ -0,0 +1,65 @@
+package free_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/free"
+)
+
+// fakeLane implements blockbuster.Lane by embedding the interface and
+// overriding only Match, which is all FreeLaneDecorator needs.
+type fakeLane struct {
+	blockbuster.Lane
+	matches bool
+}
+
+func (f fakeLane) Match(_ sdk.Context, _ sdk.Tx) bool { return f.matches }
+
+// feeTx is a minimal sdk.FeeTx used to exercise the gas-limit check.
+type feeTx struct {
+	gas uint64
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg         { return nil }
+func (tx feeTx) GetGas() uint64             { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins          { return sdk.NewCoins() }
+func (tx feeTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx feeTx) FeeGranter() sdk.AccAddress { return nil }
+
+var errDeductFeeCalled = errors.New("deduct fee decorator was called")
+
+type fakeDeductFeeDecorator struct{}
+
+func (fakeDeductFeeDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, errDeductFeeCalled
+}
+
+func noopNext(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+func TestFreeLaneDecorator(t *testing.T) {
+	t.Run("delegates non-matching transactions to the wrapped fee decorator", func(t *testing.T) {
+		decorator := free.NewFreeLaneDecorator(fakeLane{matches: false}, fakeDeductFeeDecorator{}, 100)
+
+		_, err := decorator.AnteHandle(sdk.Context{}, feeTx{gas: 10}, false, noopNext)
+		require.ErrorIs(t, err, errDeductFeeCalled)
+	})
+
+	t.Run("skips fee deduction for matching transactions under the gas limit", func(t *testing.T) {
+		decorator := free.NewFreeLaneDecorator(fakeLane{matches: true}, fakeDeductFeeDecorator{}, 100)
+
+		_, err := decorator.AnteHandle(sdk.Context{}, feeTx{gas: 10}, false, noopNext)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects matching transactions over the gas limit", func(t *testing.T) {
+		decorator := free.NewFreeLaneDecorator(fakeLane{matches: true}, fakeDeductFeeDecorator{}, 100)
+
+		_, err := decorator.AnteHandle(sdk.Context{}, feeTx{gas: 1000}, false, noopNext)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,122 @@
+package free
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/constructor"
+)
+
+// LaneName is the name of the free lane.
+const LaneName = "free"
+
+// StakingKeeper defines the subset of the x/staking keeper that the free
+// lane needs in order to order transactions by the bonded stake of their
+// first signer.
+type StakingKeeper interface {
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) math.Int
+}
+
+// NewLane returns a new free lane. The lane matches transactions whose
+// messages are all of a configured, fee-exempt set of types (delegations,
+// IBC relayer messages, oracle votes, etc.) and orders its mempool by the
+// bonded stake of each transaction's first signer, highest first.
+func NewLane(
+	cfg blockbuster.BaseLaneConfig,
+	mempool blockbuster.LaneMempool,
+	stakingKeeper StakingKeeper,
+	typeURLs []string,
+) *constructor.LaneConstructor[string] {
+	lane := constructor.NewLaneConstructor[string](cfg, LaneName, mempool, MatchHandler(typeURLs))
+	lane.SetPrepareLaneHandler(PrepareLaneHandler(lane, stakingKeeper))
+
+	return lane
+}
+
+// MatchHandler returns a MatchHandler that matches transactions whose
+// messages are all among typeURLs.
+func MatchHandler(typeURLs []string) blockbuster.MatchHandler {
+	allowed := make(map[string]struct{}, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		allowed[typeURL] = struct{}{}
+	}
+
+	return func(_ sdk.Context, tx sdk.Tx) bool {
+		msgs := tx.GetMsgs()
+		if len(msgs) == 0 {
+			return false
+		}
+
+		for _, msg := range msgs {
+			if _, ok := allowed[sdk.MsgTypeURL(msg)]; !ok {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// PrepareLaneHandler returns a PrepareLaneHandler that fills the proposal
+// with the lane's mempool transactions ordered by the bonded stake of each
+// transaction's first signer, highest stake first, up to the lane's
+// allotted block space.
+func PrepareLaneHandler(lane *constructor.LaneConstructor[string], stakingKeeper StakingKeeper) blockbuster.PrepareLaneHandler {
+	return func(ctx sdk.Context, proposal blockbuster.Proposal) ([][]byte, [][]byte, error) {
+		var (
+			txsToInclude [][]byte
+			txsToExclude [][]byte
+		)
+
+		limit := proposal.LaneLimit(lane.Name())
+
+		candidates := make([]sdk.Tx, 0, lane.CountTx())
+		iterator := lane.Select(ctx, nil)
+		for iterator != nil {
+			candidates = append(candidates, iterator.Tx())
+			iterator = iterator.Next()
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return stakeOf(ctx, lane, stakingKeeper, candidates[i]).GT(stakeOf(ctx, lane, stakingKeeper, candidates[j]))
+		})
+
+		var total int64
+		for _, tx := range candidates {
+			if err := lane.VerifyTx(ctx, tx); err != nil {
+				txBz, encErr := lane.TxEncoder()(tx)
+				if encErr == nil {
+					txsToExclude = append(txsToExclude, txBz)
+				}
+				continue
+			}
+
+			txBz, err := lane.TxEncoder()(tx)
+			if err != nil {
+				continue
+			}
+
+			if total+int64(len(txBz)) > limit {
+				continue
+			}
+
+			txsToInclude = append(txsToInclude, txBz)
+			total += int64(len(txBz))
+		}
+
+		return txsToInclude, txsToExclude, nil
+	}
+}
+
+// stakeOf returns the bonded stake of tx's first signer, or zero if the
+// signer cannot be determined.
+func stakeOf(ctx sdk.Context, lane *constructor.LaneConstructor[string], stakingKeeper StakingKeeper, tx sdk.Tx) math.Int {
+	signers, err := lane.SignerExtractor().GetSigners(tx)
+	if err != nil || len(signers) == 0 {
+		return math.ZeroInt()
+	}
+
+	return stakingKeeper.GetDelegatorBonded(ctx, signers[0].Signer)
+}
@@ -0,0 +1,41 @@
+package free_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster/lanes/free"
+)
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func TestMatchHandler(t *testing.T) {
+	matchHandler := free.MatchHandler([]string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+
+	t.Run("matches an allowed message type", func(t *testing.T) {
+		tx := testTx{msgs: []sdk.Msg{&stakingtypes.MsgDelegate{}}}
+		require.True(t, matchHandler(sdk.Context{}, tx))
+	})
+
+	t.Run("rejects a disallowed message type", func(t *testing.T) {
+		tx := testTx{msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+		require.False(t, matchHandler(sdk.Context{}, tx))
+	})
+
+	t.Run("rejects a mix of allowed and disallowed message types", func(t *testing.T) {
+		tx := testTx{msgs: []sdk.Msg{&stakingtypes.MsgDelegate{}, &banktypes.MsgSend{}}}
+		require.False(t, matchHandler(sdk.Context{}, tx))
+	})
+
+	t.Run("rejects a transaction with no messages", func(t *testing.T) {
+		require.False(t, matchHandler(sdk.Context{}, testTx{}))
+	})
+}
@@ -0,0 +1,139 @@
+package free_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/free"
+)
+
+// signedTx is a minimal authsigning.SigVerifiableTx fixture: a transaction
+// with a single, fixed signer and no real signature material.
+type signedTx struct {
+	msgs   []sdk.Msg
+	signer sdk.AccAddress
+}
+
+func (tx signedTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx signedTx) GetSigners() ([]sdk.AccAddress, error) { return []sdk.AccAddress{tx.signer}, nil }
+
+func (tx signedTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+
+func (tx signedTx) GetSignaturesV2() ([]signingtypes.SignatureV2, error) {
+	return []signingtypes.SignatureV2{{Sequence: 0}}, nil
+}
+
+// fakeMempool is a minimal, unordered blockbuster.LaneMempool backed by a
+// slice, sufficient for exercising a lane's PrepareLaneHandler in isolation.
+type fakeMempool struct {
+	txs []sdk.Tx
+}
+
+func (m *fakeMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	m.txs = append(m.txs, tx)
+	return nil
+}
+
+func (m *fakeMempool) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
+	if len(m.txs) == 0 {
+		return nil
+	}
+
+	return &fakeIterator{txs: m.txs, index: 0}
+}
+
+func (m *fakeMempool) CountTx() int { return len(m.txs) }
+
+func (m *fakeMempool) Remove(tx sdk.Tx) error {
+	for i, existing := range m.txs {
+		if existing == tx {
+			m.txs = append(m.txs[:i], m.txs[i+1:]...)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (m *fakeMempool) Contains(tx sdk.Tx) bool {
+	for _, existing := range m.txs {
+		if existing == tx {
+			return true
+		}
+	}
+
+	return false
+}
+
+type fakeIterator struct {
+	txs   []sdk.Tx
+	index int
+}
+
+func (it *fakeIterator) Tx() sdk.Tx { return it.txs[it.index] }
+
+func (it *fakeIterator) Next() sdkmempool.Iterator {
+	if it.index+1 >= len(it.txs) {
+		return nil
+	}
+
+	return &fakeIterator{txs: it.txs, index: it.index + 1}
+}
+
+// fakeStakingKeeper reports a fixed bonded amount per delegator address.
+type fakeStakingKeeper struct {
+	bonded map[string]math.Int
+}
+
+func (k fakeStakingKeeper) GetDelegatorBonded(_ sdk.Context, delegator sdk.AccAddress) math.Int {
+	if amt, ok := k.bonded[delegator.String()]; ok {
+		return amt
+	}
+
+	return math.ZeroInt()
+}
+
+func TestPrepareLaneHandlerOrdersByStake(t *testing.T) {
+	whale := sdk.AccAddress([]byte("whale_______________"))
+	minnow := sdk.AccAddress([]byte("minnow______________"))
+
+	whaleTx := signedTx{msgs: []sdk.Msg{&stakingtypes.MsgDelegate{}}, signer: whale}
+	minnowTx := signedTx{msgs: []sdk.Msg{&stakingtypes.MsgDelegate{}}, signer: minnow}
+
+	mempool := &fakeMempool{txs: []sdk.Tx{minnowTx, whaleTx}}
+	stakingKeeper := fakeStakingKeeper{bonded: map[string]math.Int{
+		whale.String():  math.NewInt(1_000_000),
+		minnow.String(): math.NewInt(10),
+	}}
+
+	cfg := blockbuster.BaseLaneConfig{
+		Logger:        log.NewNopLogger(),
+		TxEncoder:     func(tx sdk.Tx) ([]byte, error) { return []byte(tx.(signedTx).signer.String()), nil },
+		TxDecoder:     func(txBz []byte) (sdk.Tx, error) { return signedTx{signer: sdk.AccAddress(txBz)}, nil },
+		MaxBlockSpace: math.LegacyOneDec(),
+	}
+
+	lane := free.NewLane(cfg, mempool, stakingKeeper, []string{sdk.MsgTypeURL(&stakingtypes.MsgDelegate{})})
+
+	proposal := blockbuster.NewProposal(1024)
+	proposal.LaneLimits[lane.Name()] = math.LegacyOneDec()
+
+	txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(sdk.Context{}, proposal)
+	require.NoError(t, err)
+	require.Empty(t, txsToExclude)
+	require.Len(t, txsToInclude, 2)
+
+	require.Equal(t, whale.String(), string(txsToInclude[0]), "higher-stake signer should be ordered first")
+	require.Equal(t, minnow.String(), string(txsToInclude[1]))
+}
@@ -0,0 +1,92 @@
+package blockbuster
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// Proposal carries the running metadata for a block proposal as it is
+// threaded through the chain of lanes. Each lane's ProcessLaneHandler (and
+// PrepareLaneHandler, on the proposer side) reads and updates a Proposal so
+// that the next lane in the chain knows what space remains, and which lanes
+// have already had a chance to claim transactions.
+type Proposal struct {
+	// Txs is the ordered list of transactions that have been committed to
+	// the proposal so far by the lanes that have already run.
+	Txs [][]byte
+
+	// MaxTxBytes is the maximum number of bytes allowed in the proposal.
+	MaxTxBytes int64
+
+	// TotalTxBytes is the number of bytes already consumed by Txs.
+	TotalTxBytes int64
+
+	// LaneLimits records, per lane name, the maximum percentage of the
+	// remaining block space that lane is allowed to consume.
+	LaneLimits map[string]math.LegacyDec
+
+	// ConsumedBytes records, per lane name, the number of bytes that lane
+	// has consumed so far.
+	ConsumedBytes map[string]int64
+
+	// SeenLanes is the set of lane names that have already run against this
+	// proposal.
+	SeenLanes map[string]struct{}
+}
+
+// NewProposal returns a new Proposal sized to the given max tx bytes, ready
+// to be fed into the first lane in the chain.
+func NewProposal(maxTxBytes int64) Proposal {
+	return Proposal{
+		Txs:           make([][]byte, 0),
+		MaxTxBytes:    maxTxBytes,
+		LaneLimits:    make(map[string]math.LegacyDec),
+		ConsumedBytes: make(map[string]int64),
+		SeenLanes:     make(map[string]struct{}),
+	}
+}
+
+// RemainingBytes returns the number of bytes left in the proposal.
+func (p Proposal) RemainingBytes() int64 {
+	return p.MaxTxBytes - p.TotalTxBytes
+}
+
+// LaneHasSeen returns true if the named lane has already claimed its
+// transactions for this proposal.
+func (p Proposal) LaneHasSeen(laneName string) bool {
+	_, ok := p.SeenLanes[laneName]
+	return ok
+}
+
+// LaneLimit returns the maximum number of bytes the named lane is allowed to
+// consume out of what currently remains in the proposal.
+func (p Proposal) LaneLimit(laneName string) int64 {
+	ratio, ok := p.LaneLimits[laneName]
+	if !ok {
+		return 0
+	}
+
+	return ratio.MulInt64(p.RemainingBytes()).TruncateInt().Int64()
+}
+
+// UpdateProposal appends txs claimed by laneName to the proposal, updates
+// the running byte totals (both overall and per-lane), and marks laneName
+// as seen. It returns an error if the lane attempts to claim more space
+// than remains in the proposal.
+func (p Proposal) UpdateProposal(laneName string, txs [][]byte, txBytes int64) (Proposal, error) {
+	if txBytes > p.RemainingBytes() {
+		return p, fmt.Errorf("lane %s: claimed %d bytes but only %d remain in the proposal", laneName, txBytes, p.RemainingBytes())
+	}
+
+	p.Txs = append(p.Txs, txs...)
+	p.TotalTxBytes += txBytes
+	p.ConsumedBytes[laneName] += txBytes
+
+	if p.SeenLanes == nil {
+		p.SeenLanes = make(map[string]struct{})
+	}
+	p.SeenLanes[laneName] = struct{}{}
+
+	return p, nil
+}
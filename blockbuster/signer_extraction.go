@@ -0,0 +1,71 @@
+package blockbuster
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SignerData holds the signer of a transaction along with the sequence
+// number it signed with.
+type SignerData struct {
+	Signer   sdk.AccAddress
+	Sequence uint64
+}
+
+// SignerExtractionAdapter answers "who signed this tx, and with what
+// sequence number" on behalf of a lane. Lanes that need to order or dedupe
+// transactions by signer (the MEV auction lane, the free lane, the
+// nonce-aware default lane) call through this adapter instead of reaching
+// into sdk.Tx / authsigning directly, so that chains which wrap
+// transactions (EVM-on-Cosmos, IBC relayed bundles, packet-forward-routed
+// txs, etc.) can plug in their own extraction logic once instead of forking
+// every lane.
+type SignerExtractionAdapter interface {
+	GetSigners(tx sdk.Tx) ([]SignerData, error)
+}
+
+// DefaultSignerExtractionAdapter is the SignerExtractionAdapter used when a
+// lane is not configured with one explicitly. It preserves the behavior
+// lanes had before SignerExtractionAdapter was introduced: it requires tx to
+// implement authsigning.SigVerifiableTx and reads the signers and sequence
+// numbers off of it directly.
+type DefaultSignerExtractionAdapter struct{}
+
+// NewDefaultSignerExtractionAdapter returns a DefaultSignerExtractionAdapter.
+func NewDefaultSignerExtractionAdapter() DefaultSignerExtractionAdapter {
+	return DefaultSignerExtractionAdapter{}
+}
+
+// GetSigners implements SignerExtractionAdapter.
+func (DefaultSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]SignerData, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, fmt.Errorf("tx of type %T does not implement authsigning.SigVerifiableTx", tx)
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sigs) != len(signers) {
+		return nil, fmt.Errorf("expected %d signatures, got %d", len(signers), len(sigs))
+	}
+
+	signerData := make([]SignerData, len(signers))
+	for i, signer := range signers {
+		signerData[i] = SignerData{
+			Signer:   signer,
+			Sequence: sigs[i].Sequence,
+		}
+	}
+
+	return signerData, nil
+}
@@ -0,0 +1,148 @@
+package blockbuster
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// MatchHandler is the function signature used to determine if a transaction
+// belongs to a lane. Each lane must implement this function.
+type MatchHandler func(ctx sdk.Context, tx sdk.Tx) bool
+
+// PrepareLaneHandler is the function signature used to fill a proposal with
+// transactions from a single lane's mempool. It returns the subset of the
+// lane's mempool that was selected for inclusion along with the
+// transactions that should be excluded from the mempool entirely (e.g.
+// because they failed verification while being selected).
+type PrepareLaneHandler func(ctx sdk.Context, proposal Proposal) (txsToInclude [][]byte, txsToExclude [][]byte, err error)
+
+// ProcessLaneHandler is the function signature used to verify that the
+// transactions belonging to a lane that are included in a proposal are
+// valid. It is handed the remaining transactions of the proposal (i.e. the
+// transactions not yet claimed by a preceding lane) along with the running
+// Proposal metadata, and returns the txs it does not claim, unmodified and
+// in order, together with the Proposal updated to reflect its claim. The
+// claimed txs are not returned themselves; they are recorded, encoded, in
+// the updated Proposal's Txs. The returned txs are passed to the next lane. Because a lane only ever consumes a prefix of the remaining txs that
+// match it, ordering between lanes is enforced implicitly - a lane's
+// transactions can never be interleaved with a later lane's.
+type ProcessLaneHandler func(ctx sdk.Context, proposal Proposal, txs []sdk.Tx) (remainingTxs []sdk.Tx, updatedProposal Proposal, err error)
+
+// CheckOrderHandler is the function signature that was previously used to
+// verify that the transactions included in a proposal that belong to a given
+// lane respect the ordering rules of the lane.
+//
+// Deprecated: ProcessLaneHandler now enforces ordering implicitly by only
+// claiming the prefix of remaining transactions that match a lane, so a
+// second ordering pass is no longer necessary. CheckOrderHandler is kept
+// around so that lanes built before this change still compile, but it is no
+// longer invoked by the ABCI proposal handlers.
+type CheckOrderHandler func(ctx sdk.Context, txs []sdk.Tx) error
+
+// Lane defines the interface that a block-space lane must implement. A lane
+// is responsible for maintaining its own mempool of transactions, matching
+// incoming transactions against its own criteria, and filling/verifying its
+// portion of a block proposal.
+type Lane interface {
+	LaneMempool
+
+	// Name returns the name of the lane.
+	Name() string
+
+	// Match determines whether a transaction belongs to this lane.
+	Match(ctx sdk.Context, tx sdk.Tx) bool
+
+	// VerifyTx verifies that a transaction respects the lane's ante handler.
+	VerifyTx(ctx sdk.Context, tx sdk.Tx) error
+
+	// Logger returns the lane's logger.
+	Logger() log.Logger
+
+	// TxDecoder returns the decoder used for this lane's transactions.
+	TxDecoder() sdk.TxDecoder
+
+	// SetAnteHandler sets the ante handler used to verify transactions
+	// belonging to this lane.
+	SetAnteHandler(anteHandler sdk.AnteHandler)
+
+	// GetMaxBlockSpace returns the maximum percentage of block space the
+	// lane is allowed to consume.
+	GetMaxBlockSpace() math.LegacyDec
+
+	// PrepareLaneHandler returns the handler used to fill a proposal with
+	// transactions from this lane's mempool.
+	PrepareLaneHandler() PrepareLaneHandler
+
+	// ProcessLaneHandler returns the handler used to verify and claim this
+	// lane's transactions out of a proposal.
+	ProcessLaneHandler() ProcessLaneHandler
+}
+
+// LaneMempool defines the functionality a lane's underlying mempool must
+// implement. It mirrors the cosmos-sdk mempool.Mempool interface, with
+// Contains added so that a lane running later in the chain can skip
+// transactions already claimed by a lane that ran before it.
+type LaneMempool interface {
+	sdkmempool.Mempool
+
+	// Contains returns true if the transaction is already in the mempool.
+	Contains(tx sdk.Tx) bool
+
+	// CountTx returns the number of transactions currently in the mempool.
+	CountTx() int
+}
+
+// BaseLaneConfig defines the basic functionality needed for a lane. This is
+// used to create a new base lane. BaseLaneConfig is shared across all lanes
+// that are instantiated with NewLaneConstructor.
+type BaseLaneConfig struct {
+	Logger log.Logger
+
+	TxEncoder sdk.TxEncoder
+	TxDecoder sdk.TxDecoder
+
+	// MaxBlockSpace is the maximum percentage of block space that a lane is
+	// allowed to consume, expressed as a decimal in [0, 1].
+	MaxBlockSpace math.LegacyDec
+
+	// AnteHandler is used to verify transactions that belong to this lane.
+	AnteHandler sdk.AnteHandler
+
+	// SignerExtractor is used to recover the signers of a transaction (and
+	// the sequence number each signed with) without lane code having to
+	// reach into sdk.Tx / authsigning directly. It defaults to
+	// NewDefaultSignerExtractionAdapter if left unset.
+	SignerExtractor SignerExtractionAdapter
+
+	// IgnoreList is the list of lanes to ignore when processing transactions
+	// for this lane. This is useful for when a lane wants to ignore
+	// transactions that match another lane, even if those transactions also
+	// match its own MatchHandler (e.g. the default lane ignoring everything
+	// that matches the MEV lane).
+	IgnoreList []Lane
+}
+
+// ValidateBasic validates the lane configuration.
+func (cfg BaseLaneConfig) ValidateBasic() error {
+	if cfg.Logger == nil {
+		return fmt.Errorf("logger cannot be nil")
+	}
+
+	if cfg.TxEncoder == nil {
+		return fmt.Errorf("tx encoder cannot be nil")
+	}
+
+	if cfg.TxDecoder == nil {
+		return fmt.Errorf("tx decoder cannot be nil")
+	}
+
+	if cfg.MaxBlockSpace.IsNil() || cfg.MaxBlockSpace.IsNegative() || cfg.MaxBlockSpace.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max block space must be in the range [0, 1]")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package bid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/skip-mev/pob/x/builder/searcher"
+)
+
+// RegisterHandlers mounts the searcher HTTP gateway on mux, proxying
+// requests to the Searcher gRPC service implementation directly (in-process)
+// so that searchers who prefer REST/JSON over gRPC can still submit and
+// simulate bids.
+func RegisterHandlers(mux *http.ServeMux, server *searcher.Server) {
+	mux.HandleFunc("/pob/builder/v1/bid/submit", handleBid(server.SubmitBid))
+	mux.HandleFunc("/pob/builder/v1/bid/simulate", handleBid(server.SimulateBid))
+}
+
+type bidHandlerFunc func(ctx context.Context, req *searcher.BidRequest) (*searcher.BidResponse, error)
+
+func handleBid(handle bidHandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req searcher.BidRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp, err := handle(r.Context(), &req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/constructor"
+	"github.com/skip-mev/pob/blockbuster/lanes/free"
+	"github.com/skip-mev/pob/x/builder/lanes/mev"
+)
+
+// DefaultLaneName is the name of the catch-all lane that everything not
+// claimed by the MEV or free lanes falls into.
+const DefaultLaneName = "default"
+
+// FreeLaneTypeURLs is the set of message types the example app's free lane
+// fee-exempts.
+var FreeLaneTypeURLs = []string{
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+}
+
+// NewLanes builds the example app's default three-lane stack, in the order
+// the ABCI proposal handlers should run them: MEV auction bids first, then
+// fee-exempt free-lane transactions, then everything else.
+//
+// Each lane ignores the lanes that run before it, so that a transaction
+// matching an earlier lane's criteria (e.g. a MsgAuctionBid) is never also
+// picked up by a later one.
+func NewLanes(
+	logger log.Logger,
+	txEncoder sdk.TxEncoder,
+	txDecoder sdk.TxDecoder,
+	stakingKeeper free.StakingKeeper,
+	mevMempool, freeMempool, defaultMempool blockbuster.LaneMempool,
+) (mevLane, freeLane, defaultLane *constructor.LaneConstructor[string]) {
+	mevCfg := blockbuster.BaseLaneConfig{
+		Logger:        logger,
+		TxEncoder:     txEncoder,
+		TxDecoder:     txDecoder,
+		MaxBlockSpace: math.LegacyMustNewDecFromStr("0.2"),
+	}
+	mevLane = mev.NewLane(mevCfg, mevMempool)
+
+	freeCfg := blockbuster.BaseLaneConfig{
+		Logger:        logger,
+		TxEncoder:     txEncoder,
+		TxDecoder:     txDecoder,
+		MaxBlockSpace: math.LegacyMustNewDecFromStr("0.3"),
+		IgnoreList:    []blockbuster.Lane{mevLane},
+	}
+	freeLane = free.NewLane(freeCfg, freeMempool, stakingKeeper, FreeLaneTypeURLs)
+
+	defaultCfg := blockbuster.BaseLaneConfig{
+		Logger:        logger,
+		TxEncoder:     txEncoder,
+		TxDecoder:     txDecoder,
+		MaxBlockSpace: math.LegacyMustNewDecFromStr("0.5"),
+		IgnoreList:    []blockbuster.Lane{mevLane, freeLane},
+	}
+	defaultLane = constructor.NewLaneConstructor[string](defaultCfg, DefaultLaneName, defaultMempool, matchAll())
+
+	return mevLane, freeLane, defaultLane
+}
+
+// matchAll returns a MatchHandler that matches every transaction; used by
+// the catch-all default lane, which relies on its IgnoreList to defer to the
+// lanes that run before it.
+func matchAll() blockbuster.MatchHandler {
+	return func(_ sdk.Context, _ sdk.Tx) bool { return true }
+}
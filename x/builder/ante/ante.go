@@ -0,0 +1,99 @@
+package ante
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/x/builder/lanes/mev"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// AuctionDecorator validates MsgAuctionBid transactions. It ensures that
+// every bundled transaction is well formed and, via the configured
+// SignerExtractionAdapter, that each bundled transaction's signer is signing
+// with the sequence number the bidder claims to hold for it - preventing a
+// searcher from bundling a transaction they cannot actually get included.
+type AuctionDecorator struct {
+	txDecoder       sdk.TxDecoder
+	signerExtractor blockbuster.SignerExtractionAdapter
+}
+
+// NewAuctionDecorator returns a new AuctionDecorator.
+func NewAuctionDecorator(txDecoder sdk.TxDecoder, signerExtractor blockbuster.SignerExtractionAdapter) AuctionDecorator {
+	return AuctionDecorator{
+		txDecoder:       txDecoder,
+		signerExtractor: signerExtractor,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator. Transactions that do not carry a
+// MsgAuctionBid are passed through unmodified.
+func (ad AuctionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	bid, err := mev.GetMsgAuctionBid(tx)
+	if err != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := ad.verifyBid(tx, bid); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// verifyBid checks that the bid transaction and every transaction it bundles
+// can be decoded, have extractable signers, and (if BundleTx.Hints claims an
+// expected signer) that the claim is accurate. A bundled transaction marked
+// BundleTx.Revertible does not invalidate the rest of the bundle if it fails
+// this check - it is simply left for execution to (possibly) revert later.
+func (ad AuctionDecorator) verifyBid(bidTx sdk.Tx, bid *types.MsgAuctionBid) error {
+	if _, err := ad.signerExtractor.GetSigners(bidTx); err != nil {
+		return fmt.Errorf("failed to extract bid signer: %w", err)
+	}
+
+	for i, bundledTx := range bid.Bundle.Txs {
+		decodedTx, err := ad.txDecoder(bundledTx.Raw)
+		if err != nil {
+			if bundledTx.Revertible {
+				continue
+			}
+			return fmt.Errorf("failed to decode bundled transaction %d: %w", i, err)
+		}
+
+		if err := ad.verifySigner(decodedTx, bundledTx.Hints); err != nil {
+			if bundledTx.Revertible {
+				continue
+			}
+			return fmt.Errorf("failed to extract signer of bundled transaction %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// verifySigner confirms that a bundled transaction has an extractable
+// signer and, if hints claims an expected signer and sequence, that one of
+// the transaction's real signers matches that claim. hints never lets a
+// transaction skip signer extraction itself - only a downstream consumer
+// that has already extracted signers once (e.g. the MEV lane's
+// PrepareLaneHandler, building on a bid it has already run through this same
+// AnteHandle) can use a verified hint to skip doing so again.
+func (ad AuctionDecorator) verifySigner(tx sdk.Tx, hints types.BundleHints) error {
+	signers, err := ad.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return err
+	}
+
+	if hints.ExpectedSigner == "" {
+		return nil
+	}
+
+	for _, signer := range signers {
+		if signer.Signer.String() == hints.ExpectedSigner && signer.Sequence == hints.ExpectedSequence {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no signer of the bundled transaction matches hints (expected %s at sequence %d)", hints.ExpectedSigner, hints.ExpectedSequence)
+}
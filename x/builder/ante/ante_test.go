@@ -0,0 +1,131 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/x/builder/ante"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// bidTx is a minimal sdk.Tx fixture carrying a single MsgAuctionBid.
+type bidTx struct {
+	bid *types.MsgAuctionBid
+}
+
+func (tx bidTx) GetMsgs() []sdk.Msg { return []sdk.Msg{tx.bid} }
+
+// plainTx is a minimal sdk.Tx fixture identified by id, used for bundled
+// transactions.
+type plainTx struct {
+	id string
+}
+
+func (tx plainTx) GetMsgs() []sdk.Msg { return nil }
+
+// decoderFor returns a TxDecoder that fails for every id in failIDs and
+// otherwise decodes a raw transaction as a plainTx named after its bytes.
+func decoderFor(failIDs map[string]bool) sdk.TxDecoder {
+	return func(txBz []byte) (sdk.Tx, error) {
+		id := string(txBz)
+		if failIDs[id] {
+			return nil, errors.New("decode failed")
+		}
+
+		return plainTx{id: id}, nil
+	}
+}
+
+// fakeSignerExtractor extracts signer as the sole signer, at sequence, of
+// every tx except a plainTx whose id is in failIDs.
+type fakeSignerExtractor struct {
+	signer   string
+	sequence uint64
+	failIDs  map[string]bool
+}
+
+func (f fakeSignerExtractor) GetSigners(tx sdk.Tx) ([]blockbuster.SignerData, error) {
+	if pt, ok := tx.(plainTx); ok && f.failIDs[pt.id] {
+		return nil, errors.New("signer could not be extracted")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(f.signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return []blockbuster.SignerData{{Signer: addr, Sequence: f.sequence}}, nil
+}
+
+func newBid(bundledTxs ...types.BundleTx) bidTx {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+	return bidTx{bid: types.NewMsgAuctionBid(bidder, sdk.NewInt64Coin("uatom", 100), types.NewBundle(bundledTxs...))}
+}
+
+func passThrough(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func TestAuctionDecoratorAnteHandle(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer______________")).String()
+
+	t.Run("passes through a transaction without a MsgAuctionBid", func(t *testing.T) {
+		decorator := ante.NewAuctionDecorator(decoderFor(nil), fakeSignerExtractor{signer: signer})
+
+		_, err := decorator.AnteHandle(sdk.Context{}, plainTx{id: "a"}, false, passThrough)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a non-revertible bundled tx that fails to decode", func(t *testing.T) {
+		bid := newBid(types.BundleTx{Raw: []byte("bad")})
+		decorator := ante.NewAuctionDecorator(decoderFor(map[string]bool{"bad": true}), fakeSignerExtractor{signer: signer})
+
+		_, err := decorator.AnteHandle(sdk.Context{}, bid, false, passThrough)
+		require.Error(t, err)
+	})
+
+	t.Run("tolerates a revertible bundled tx that fails to decode", func(t *testing.T) {
+		bid := newBid(types.BundleTx{Raw: []byte("bad"), Revertible: true})
+		decorator := ante.NewAuctionDecorator(decoderFor(map[string]bool{"bad": true}), fakeSignerExtractor{signer: signer})
+
+		_, err := decorator.AnteHandle(sdk.Context{}, bid, false, passThrough)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a non-revertible bundled tx whose signer cannot be extracted", func(t *testing.T) {
+		bid := newBid(types.BundleTx{Raw: []byte("unsigned")})
+		decorator := ante.NewAuctionDecorator(decoderFor(nil), fakeSignerExtractor{signer: signer, failIDs: map[string]bool{"unsigned": true}})
+
+		_, err := decorator.AnteHandle(sdk.Context{}, bid, false, passThrough)
+		require.Error(t, err)
+	})
+
+	t.Run("tolerates a revertible bundled tx whose signer cannot be extracted", func(t *testing.T) {
+		bid := newBid(types.BundleTx{Raw: []byte("unsigned"), Revertible: true})
+		decorator := ante.NewAuctionDecorator(decoderFor(nil), fakeSignerExtractor{signer: signer, failIDs: map[string]bool{"unsigned": true}})
+
+		_, err := decorator.AnteHandle(sdk.Context{}, bid, false, passThrough)
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a bundled tx whose hints claim a signer it was not signed by", func(t *testing.T) {
+		other := sdk.AccAddress([]byte("someone_else________")).String()
+		bid := newBid(types.BundleTx{Raw: []byte("tx"), Hints: types.BundleHints{ExpectedSigner: other, ExpectedSequence: 1}})
+		decorator := ante.NewAuctionDecorator(decoderFor(nil), fakeSignerExtractor{signer: signer, sequence: 1})
+
+		_, err := decorator.AnteHandle(sdk.Context{}, bid, false, passThrough)
+		require.Error(t, err)
+	})
+
+	t.Run("accepts a bundled tx whose hints match its real signer", func(t *testing.T) {
+		bid := newBid(types.BundleTx{Raw: []byte("tx"), Hints: types.BundleHints{ExpectedSigner: signer, ExpectedSequence: 1}})
+		decorator := ante.NewAuctionDecorator(decoderFor(nil), fakeSignerExtractor{signer: signer, sequence: 1})
+
+		_, err := decorator.AnteHandle(sdk.Context{}, bid, false, passThrough)
+		require.NoError(t, err)
+	})
+}
@@ -2,8 +2,10 @@ package cli
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,6 +16,10 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// FlagBundleFile is the flag used to pass a v1 Bundle as a JSON file,
+// instead of the legacy positional comma-separated bundle argument.
+const FlagBundleFile = "bundle-file"
+
 // NewTxCmd returns a root CLI command handler for all x/builder transaction
 // commands.
 func NewTxCmd() *cobra.Command {
@@ -36,10 +42,17 @@ func NewAuctionBidTx() *cobra.Command {
     cmd := &cobra.Command{
         Use:   "auction-bid [bidder] [bid] [bundled_tx1_base64,bundled_tx2_base64,...,bundled_txN_base64]",
         Short: "Create an auction bid transaction with signed bundled transactions",
-        Long: `Create an auction bid transaction with a list of signed bundled transactions,
-where each transaction is a base64-encoded string of a signed transaction.
+        Long: `Create an auction bid transaction with a list of signed bundled transactions.
+
+The positional bundle argument is a comma-separated list of base64-encoded
+signed transactions. It builds the legacy v0 bundle: every transaction is
+required to succeed and carries no hints. To submit a v1 bundle with per-tx
+revert tolerance or signer/sequence hints, omit the positional argument and
+pass --bundle-file instead, pointing at a JSON file of the form:
+
+  {"txs": [{"raw": "<base64>", "revertible": true, "hints": {"expected_signer": "cosmos1...", "expected_sequence": 4}}]}
 `,
-        Args:    cobra.ExactArgs(3),
+        Args:    cobra.RangeArgs(2, 3),
         Example: "auction-bid cosmos1... 10000uatom eyJhZGRyZXNzIjo...==,eyJ2YWx1ZSI6...==",
         RunE: func(cmd *cobra.Command, args []string) error {
             if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
@@ -62,24 +75,101 @@ where each transaction is a base64-encoded string of a signed transaction.
                 return errors.New("timeout height must be greater than 0")
             }
 
-            tokens := strings.Split(args[2], ",")
-            bundledTxs := make([][]byte, len(tokens))
-            for i, token := range tokens {
-                rawTx, err := base64.StdEncoding.DecodeString(token)
-                if err != nil {
-                    return fmt.Errorf("failed to base64 decode bundled transaction %d: %w", i, err)
-                }
+            bundleFile, err := cmd.Flags().GetString(FlagBundleFile)
+            if err != nil {
+                return err
+            }
 
-                bundledTxs[i] = rawTx
+            bundle, err := resolveBundle(bundleFile, args[2:])
+            if err != nil {
+                return err
             }
 
-            msg := types.NewMsgAuctionBid(clientCtx.GetFromAddress(), bid, bundledTxs)
+            msg := types.NewMsgAuctionBid(clientCtx.GetFromAddress(), bid, bundle)
 
             return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
         },
     }
 
     flags.AddTxFlagsToCmd(cmd)
+    cmd.Flags().String(FlagBundleFile, "", "path to a JSON file describing a v1 bundle, as an alternative to the positional comma-separated bundle argument")
 
     return cmd
 }
+
+// resolveBundle builds the Bundle for an auction bid from whichever form the
+// caller used: a --bundle-file path takes precedence over the legacy
+// positional argument, and at least one of the two must be present.
+func resolveBundle(bundleFile string, legacyArg []string) (types.Bundle, error) {
+    switch {
+    case bundleFile != "":
+        return bundleFromFile(bundleFile)
+    case len(legacyArg) == 1:
+        return legacyBundleFromArg(legacyArg[0])
+    default:
+        return types.Bundle{}, errors.New("must provide either a bundled_tx1_base64,... argument or --bundle-file")
+    }
+}
+
+// legacyBundleFromArg decodes the legacy comma-separated bundle argument
+// into a v0 Bundle.
+func legacyBundleFromArg(arg string) (types.Bundle, error) {
+    tokens := strings.Split(arg, ",")
+    rawTxs := make([][]byte, len(tokens))
+    for i, token := range tokens {
+        rawTx, err := base64.StdEncoding.DecodeString(token)
+        if err != nil {
+            return types.Bundle{}, fmt.Errorf("failed to base64 decode bundled transaction %d: %w", i, err)
+        }
+
+        rawTxs[i] = rawTx
+    }
+
+    return types.NewLegacyBundle(rawTxs), nil
+}
+
+// bundleFileJSON is the JSON shape read from --bundle-file.
+type bundleFileJSON struct {
+    Txs []struct {
+        Raw        string `json:"raw"`
+        Revertible bool   `json:"revertible"`
+        Hints      struct {
+            ExpectedSigner   string `json:"expected_signer"`
+            ExpectedSequence uint64 `json:"expected_sequence"`
+            SimulationOnly   bool   `json:"simulation_only"`
+        } `json:"hints"`
+    } `json:"txs"`
+}
+
+// bundleFromFile reads and decodes a v1 Bundle from the JSON file at path.
+func bundleFromFile(path string) (types.Bundle, error) {
+    raw, err := os.ReadFile(path)
+    if err != nil {
+        return types.Bundle{}, fmt.Errorf("failed to read bundle file: %w", err)
+    }
+
+    var parsed bundleFileJSON
+    if err := json.Unmarshal(raw, &parsed); err != nil {
+        return types.Bundle{}, fmt.Errorf("failed to parse bundle file: %w", err)
+    }
+
+    txs := make([]types.BundleTx, len(parsed.Txs))
+    for i, tx := range parsed.Txs {
+        rawTx, err := base64.StdEncoding.DecodeString(tx.Raw)
+        if err != nil {
+            return types.Bundle{}, fmt.Errorf("failed to base64 decode bundled transaction %d: %w", i, err)
+        }
+
+        txs[i] = types.BundleTx{
+            Raw:        rawTx,
+            Revertible: tx.Revertible,
+            Hints: types.BundleHints{
+                ExpectedSigner:   tx.Hints.ExpectedSigner,
+                ExpectedSequence: tx.Hints.ExpectedSequence,
+                SimulationOnly:   tx.Hints.SimulationOnly,
+            },
+        }
+    }
+
+    return types.NewBundle(txs...), nil
+}
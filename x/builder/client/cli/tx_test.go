@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func TestResolveBundle(t *testing.T) {
+	t.Run("builds a legacy bundle from the positional argument", func(t *testing.T) {
+		arg := base64.StdEncoding.EncodeToString([]byte("tx1")) + "," + base64.StdEncoding.EncodeToString([]byte("tx2"))
+
+		bundle, err := resolveBundle("", []string{arg})
+		require.NoError(t, err)
+		require.Equal(t, types.BundleVersionLegacy, bundle.Version)
+		require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, bundle.RawTxs())
+	})
+
+	t.Run("builds a v1 bundle from --bundle-file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bundle.json")
+		contents := `{"txs": [{"raw": "` + base64.StdEncoding.EncodeToString([]byte("tx1")) + `", "revertible": true, "hints": {"expected_signer": "cosmos1abc", "expected_sequence": 4}}]}`
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+		bundle, err := resolveBundle(path, nil)
+		require.NoError(t, err)
+		require.Equal(t, types.BundleVersionCurrent, bundle.Version)
+		require.Len(t, bundle.Txs, 1)
+		require.Equal(t, []byte("tx1"), bundle.Txs[0].Raw)
+		require.True(t, bundle.Txs[0].Revertible)
+		require.Equal(t, types.BundleHints{ExpectedSigner: "cosmos1abc", ExpectedSequence: 4}, bundle.Txs[0].Hints)
+	})
+
+	t.Run("--bundle-file takes precedence over the positional argument", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "bundle.json")
+		contents := `{"txs": [{"raw": "` + base64.StdEncoding.EncodeToString([]byte("from-file")) + `"}]}`
+		require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+		arg := base64.StdEncoding.EncodeToString([]byte("from-arg"))
+
+		bundle, err := resolveBundle(path, []string{arg})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("from-file")}, bundle.RawTxs())
+	})
+
+	t.Run("errors when neither form is provided", func(t *testing.T) {
+		_, err := resolveBundle("", nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,244 @@
+package mev
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/blockbuster/lanes/constructor"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// LaneName is the name of the MEV auction lane.
+const LaneName = "mev"
+
+// NewLane returns a new MEV auction lane. The lane matches transactions that
+// contain a MsgAuctionBid, orders its mempool by bid amount (highest first),
+// and claims at most one such transaction - along with its bundled txs - per
+// proposal.
+func NewLane(cfg blockbuster.BaseLaneConfig, mempool blockbuster.LaneMempool) *constructor.LaneConstructor[string] {
+	lane := constructor.NewLaneConstructor[string](cfg, LaneName, mempool, MatchHandler())
+	lane.SetPrepareLaneHandler(PrepareLaneHandler(lane))
+	lane.SetProcessLaneHandler(ProcessLaneHandler(lane))
+
+	return lane
+}
+
+// MatchHandler returns a MatchHandler that matches transactions containing
+// exactly one MsgAuctionBid.
+func MatchHandler() blockbuster.MatchHandler {
+	return func(_ sdk.Context, tx sdk.Tx) bool {
+		_, err := GetMsgAuctionBid(tx)
+		return err == nil
+	}
+}
+
+// GetMsgAuctionBid returns the MsgAuctionBid carried by tx, or an error if
+// tx does not contain exactly one.
+func GetMsgAuctionBid(tx sdk.Tx) (*types.MsgAuctionBid, error) {
+	var bid *types.MsgAuctionBid
+
+	for _, msg := range tx.GetMsgs() {
+		auctionBid, ok := msg.(*types.MsgAuctionBid)
+		if !ok {
+			continue
+		}
+
+		if bid != nil {
+			return nil, fmt.Errorf("only one MsgAuctionBid is allowed per transaction")
+		}
+
+		bid = auctionBid
+	}
+
+	if bid == nil {
+		return nil, fmt.Errorf("transaction does not contain a MsgAuctionBid")
+	}
+
+	return bid, nil
+}
+
+// PrepareLaneHandler returns a PrepareLaneHandler that selects the
+// highest-bidding valid auction transaction in the lane's mempool, along
+// with its bundled transactions, to fill the lane's slot in the proposal.
+func PrepareLaneHandler(lane *constructor.LaneConstructor[string]) blockbuster.PrepareLaneHandler {
+	return func(ctx sdk.Context, proposal blockbuster.Proposal) ([][]byte, [][]byte, error) {
+		var (
+			txsToInclude [][]byte
+			txsToExclude [][]byte
+		)
+
+		limit := proposal.LaneLimit(lane.Name())
+
+		candidates := make([]sdk.Tx, 0, lane.CountTx())
+		iterator := lane.Select(ctx, nil)
+		for iterator != nil {
+			candidates = append(candidates, iterator.Tx())
+			iterator = iterator.Next()
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			bidI, _ := GetMsgAuctionBid(candidates[i])
+			bidJ, _ := GetMsgAuctionBid(candidates[j])
+			return bidI.Bid.IsGTE(bidJ.Bid)
+		})
+
+		for _, tx := range candidates {
+			if err := lane.VerifyTx(ctx, tx); err != nil {
+				txBz, encErr := lane.TxEncoder()(tx)
+				if encErr == nil {
+					txsToExclude = append(txsToExclude, txBz)
+				}
+				continue
+			}
+
+			bundle, err := bundleBytes(lane, tx)
+			if err != nil {
+				continue
+			}
+
+			var total int64
+			for _, txBz := range bundle {
+				total += int64(len(txBz))
+			}
+
+			if total > limit {
+				continue
+			}
+
+			txsToInclude = bundle
+			break
+		}
+
+		return txsToInclude, txsToExclude, nil
+	}
+}
+
+// ProcessLaneHandler returns a ProcessLaneHandler that claims a single
+// leading auction transaction (and its bundle) from the remaining proposal
+// txs. Unlike the default lane, the MEV lane only ever claims a bid once per
+// proposal - a second MsgAuctionBid later in the remaining txs is left for
+// whichever lane runs after it to reject.
+func ProcessLaneHandler(lane *constructor.LaneConstructor[string]) blockbuster.ProcessLaneHandler {
+	return func(ctx sdk.Context, proposal blockbuster.Proposal, txs []sdk.Tx) ([]sdk.Tx, blockbuster.Proposal, error) {
+		if len(txs) == 0 || proposal.LaneHasSeen(lane.Name()) || !lane.Match(ctx, txs[0]) {
+			return txs, proposal, nil
+		}
+
+		bidTx := txs[0]
+		if err := lane.VerifyTx(ctx, bidTx); err != nil {
+			return txs, proposal, fmt.Errorf("invalid auction bid: %w", err)
+		}
+
+		bundle, err := bundleBytes(lane, bidTx)
+		if err != nil {
+			return txs, proposal, err
+		}
+
+		var total int64
+		for _, txBz := range bundle {
+			total += int64(len(txBz))
+		}
+
+		updatedProposal, err := proposal.UpdateProposal(lane.Name(), bundle, total)
+		if err != nil {
+			return txs, proposal, err
+		}
+
+		// bundle is bidTx followed by its bundled transactions, so it - not
+		// just the bid transaction itself - is the prefix of txs this lane
+		// claims; anything less leaves the bundled transactions in
+		// remaining to be re-verified standalone by the next lane, which
+		// they cannot pass on their own.
+		if len(txs) < len(bundle) {
+			return txs, proposal, fmt.Errorf("proposal is missing bundled transactions of the auction bid")
+		}
+
+		for i := 1; i < len(bundle); i++ {
+			txBz, err := lane.TxEncoder()(txs[i])
+			if err != nil || !bytes.Equal(txBz, bundle[i]) {
+				return txs, proposal, fmt.Errorf("proposal transaction %d does not match the auction bid's bundle", i)
+			}
+		}
+
+		return txs[len(bundle):], updatedProposal, nil
+	}
+}
+
+// bundleBytes encodes the bid transaction followed by its bundled
+// transactions. A bundled transaction marked BundleTx.Revertible that fails
+// to decode, or whose signer cannot be established, is dropped from the
+// proposal rather than included verbatim - mirroring the tolerance
+// x/builder/ante.AuctionDecorator grants it, so a byte blob no validator can
+// decode or attribute never ends up in a proposal. A bundled transaction
+// whose hints mark it simulation-only is left out of the proposal as well.
+func bundleBytes(lane *constructor.LaneConstructor[string], bidTx sdk.Tx) ([][]byte, error) {
+	bid, err := GetMsgAuctionBid(bidTx)
+	if err != nil {
+		return nil, err
+	}
+
+	bidBz, err := lane.TxEncoder()(bidTx)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := make([][]byte, 0, len(bid.Bundle.Txs)+1)
+	bundle = append(bundle, bidBz)
+
+	for i, bundledTx := range bid.Bundle.Txs {
+		if bundledTx.Hints.SimulationOnly {
+			continue
+		}
+
+		decodedTx, err := lane.TxDecoder()(bundledTx.Raw)
+		if err != nil {
+			if bundledTx.Revertible {
+				continue
+			}
+			return nil, fmt.Errorf("failed to decode bundled transaction %d: %w", i, err)
+		}
+
+		if _, err := bundleTxSigner(lane, decodedTx, bundledTx.Hints); err != nil {
+			if bundledTx.Revertible {
+				continue
+			}
+			return nil, fmt.Errorf("failed to determine signer of bundled transaction %d: %w", i, err)
+		}
+
+		bundle = append(bundle, bundledTx.Raw)
+	}
+
+	return bundle, nil
+}
+
+// bundleTxSigner returns the signer tx was bundled under. If hints claims an
+// expected signer, it is trusted outright rather than re-derived: tx already
+// passed x/builder/ante.AuctionDecorator's own signer extraction and hint
+// verification when the bid transaction was checked, so the lane building on
+// that same bid does not need to run signer extraction over it a second
+// time. Only when hints carries no claim does the lane fall back to
+// extracting the signer itself.
+func bundleTxSigner(lane *constructor.LaneConstructor[string], tx sdk.Tx, hints types.BundleHints) (blockbuster.SignerData, error) {
+	if hints.ExpectedSigner != "" {
+		signer, err := sdk.AccAddressFromBech32(hints.ExpectedSigner)
+		if err != nil {
+			return blockbuster.SignerData{}, fmt.Errorf("invalid expected signer in hints: %w", err)
+		}
+
+		return blockbuster.SignerData{Signer: signer, Sequence: hints.ExpectedSequence}, nil
+	}
+
+	signers, err := lane.SignerExtractor().GetSigners(tx)
+	if err != nil {
+		return blockbuster.SignerData{}, err
+	}
+
+	if len(signers) == 0 {
+		return blockbuster.SignerData{}, fmt.Errorf("transaction has no signers")
+	}
+
+	return signers[0], nil
+}
@@ -0,0 +1,240 @@
+package mev_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/x/builder/lanes/mev"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// workingSignerExtractor extracts a fixed signer for every transaction.
+type workingSignerExtractor struct{}
+
+func (workingSignerExtractor) GetSigners(_ sdk.Tx) ([]blockbuster.SignerData, error) {
+	return []blockbuster.SignerData{{Signer: sdk.AccAddress([]byte("bundled_signer______"))}}, nil
+}
+
+// failingSignerExtractor always fails to extract a signer, so that a test
+// can tell whether a bundled transaction's signer was actually re-derived.
+type failingSignerExtractor struct{}
+
+func (failingSignerExtractor) GetSigners(_ sdk.Tx) ([]blockbuster.SignerData, error) {
+	return nil, errors.New("signer extraction should not have been called")
+}
+
+// bidTx is a minimal sdk.Tx fixture carrying a single MsgAuctionBid,
+// identified by id.
+type bidTx struct {
+	id  string
+	bid *types.MsgAuctionBid
+}
+
+func (tx bidTx) GetMsgs() []sdk.Msg { return []sdk.Msg{tx.bid} }
+
+// fakeMempool is a minimal, unordered blockbuster.LaneMempool backed by a
+// slice, sufficient for exercising a lane's handlers in isolation.
+type fakeMempool struct {
+	txs []sdk.Tx
+}
+
+func (m *fakeMempool) Insert(_ context.Context, tx sdk.Tx) error {
+	m.txs = append(m.txs, tx)
+	return nil
+}
+
+func (m *fakeMempool) Select(_ context.Context, _ [][]byte) sdkmempool.Iterator {
+	if len(m.txs) == 0 {
+		return nil
+	}
+
+	return &fakeIterator{txs: m.txs, index: 0}
+}
+
+func (m *fakeMempool) CountTx() int { return len(m.txs) }
+
+func (m *fakeMempool) Remove(_ sdk.Tx) error { return nil }
+
+func (m *fakeMempool) Contains(_ sdk.Tx) bool { return false }
+
+type fakeIterator struct {
+	txs   []sdk.Tx
+	index int
+}
+
+func (it *fakeIterator) Tx() sdk.Tx { return it.txs[it.index] }
+
+func (it *fakeIterator) Next() sdkmempool.Iterator {
+	if it.index+1 >= len(it.txs) {
+		return nil
+	}
+
+	return &fakeIterator{txs: it.txs, index: it.index + 1}
+}
+
+// newBid returns a bidTx bidding amount uatom and bundling a single fixed
+// transaction, identified by id.
+func newBid(id string, amount int64) bidTx {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+	return bidTx{
+		id: id,
+		bid: types.NewMsgAuctionBid(
+			bidder,
+			sdk.NewInt64Coin("uatom", amount),
+			types.NewLegacyBundle([][]byte{[]byte("bundled-tx")}),
+		),
+	}
+}
+
+func newTestConfig() blockbuster.BaseLaneConfig {
+	return blockbuster.BaseLaneConfig{
+		Logger:          log.NewNopLogger(),
+		TxEncoder:       func(tx sdk.Tx) ([]byte, error) { return []byte(tx.(bidTx).id), nil },
+		TxDecoder:       func(txBz []byte) (sdk.Tx, error) { return bidTx{id: string(txBz)}, nil },
+		MaxBlockSpace:   math.LegacyOneDec(),
+		SignerExtractor: workingSignerExtractor{},
+	}
+}
+
+func TestPrepareLaneHandlerOrdersByBid(t *testing.T) {
+	low := newBid("low", 10)
+	high := newBid("high", 1000)
+
+	mempool := &fakeMempool{txs: []sdk.Tx{low, high}}
+	lane := mev.NewLane(newTestConfig(), mempool)
+
+	proposal := blockbuster.NewProposal(1024)
+	proposal.LaneLimits[lane.Name()] = math.LegacyOneDec()
+
+	txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(sdk.Context{}, proposal)
+	require.NoError(t, err)
+	require.Empty(t, txsToExclude)
+	require.Equal(t, [][]byte{[]byte("high"), []byte("bundled-tx")}, txsToInclude,
+		"the higher bid should be selected over the lower one")
+}
+
+func TestProcessLaneHandlerClaimsLeadingBid(t *testing.T) {
+	lane := mev.NewLane(newTestConfig(), &fakeMempool{})
+
+	bid := newBid("bid", 10)
+	proposal := blockbuster.NewProposal(1024)
+
+	// The proposal carries the bid followed by its bundled transaction.
+	remaining, updated, err := lane.ProcessLaneHandler()(sdk.Context{}, proposal, []sdk.Tx{bid, bidTx{id: "bundled-tx"}})
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+	require.Equal(t, [][]byte{[]byte("bid"), []byte("bundled-tx")}, updated.Txs)
+}
+
+func TestProcessLaneHandlerClaimsWholeMultiTxBundle(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+
+	bid := bidTx{
+		id: "bid",
+		bid: types.NewMsgAuctionBid(bidder, sdk.NewInt64Coin("uatom", 10), types.NewLegacyBundle(
+			[][]byte{[]byte("bundled-tx-1"), []byte("bundled-tx-2")},
+		)),
+	}
+
+	lane := mev.NewLane(newTestConfig(), &fakeMempool{})
+
+	proposal := blockbuster.NewProposal(1024)
+
+	// txs mirrors what ProcessProposalHandler hands the lane: the proposal's
+	// decoded transactions, bid followed by its bundled transactions as
+	// separate entries, plus one unrelated trailing transaction left for the
+	// next lane.
+	txs := []sdk.Tx{bid, bidTx{id: "bundled-tx-1"}, bidTx{id: "bundled-tx-2"}, bidTx{id: "next-lane-tx"}}
+
+	remaining, updated, err := lane.ProcessLaneHandler()(sdk.Context{}, proposal, txs)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Tx{bidTx{id: "next-lane-tx"}}, remaining,
+		"claiming only the bid tx and leaving the bundled txs in remaining would send them to the next lane, which cannot verify them standalone")
+	require.Equal(t, [][]byte{[]byte("bid"), []byte("bundled-tx-1"), []byte("bundled-tx-2")}, updated.Txs)
+}
+
+func TestPrepareLaneHandlerTrustsHintsInsteadOfReDerivingSigner(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+	hintedSigner := sdk.AccAddress([]byte("hinted_signer_______")).String()
+
+	bid := bidTx{
+		id: "bid",
+		bid: types.NewMsgAuctionBid(bidder, sdk.NewInt64Coin("uatom", 10), types.NewBundle(
+			types.BundleTx{Raw: []byte("bundled-tx"), Hints: types.BundleHints{ExpectedSigner: hintedSigner, ExpectedSequence: 2}},
+		)),
+	}
+
+	cfg := newTestConfig()
+	cfg.SignerExtractor = failingSignerExtractor{}
+
+	mempool := &fakeMempool{txs: []sdk.Tx{bid}}
+	lane := mev.NewLane(cfg, mempool)
+
+	proposal := blockbuster.NewProposal(1024)
+	proposal.LaneLimits[lane.Name()] = math.LegacyOneDec()
+
+	txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(sdk.Context{}, proposal)
+	require.NoError(t, err)
+	require.Empty(t, txsToExclude)
+	require.Equal(t, [][]byte{[]byte("bid"), []byte("bundled-tx")}, txsToInclude,
+		"a hinted signer should be trusted instead of re-derived")
+}
+
+func TestPrepareLaneHandlerExcludesBidWhenUnhintedSignerCannotBeDerived(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+
+	bid := bidTx{
+		id: "bid",
+		bid: types.NewMsgAuctionBid(bidder, sdk.NewInt64Coin("uatom", 10), types.NewBundle(
+			types.BundleTx{Raw: []byte("bundled-tx")},
+		)),
+	}
+
+	cfg := newTestConfig()
+	cfg.SignerExtractor = failingSignerExtractor{}
+
+	mempool := &fakeMempool{txs: []sdk.Tx{bid}}
+	lane := mev.NewLane(cfg, mempool)
+
+	proposal := blockbuster.NewProposal(1024)
+	proposal.LaneLimits[lane.Name()] = math.LegacyOneDec()
+
+	txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(sdk.Context{}, proposal)
+	require.NoError(t, err)
+	require.Empty(t, txsToInclude)
+	require.Empty(t, txsToExclude)
+}
+
+func TestPrepareLaneHandlerDropsRevertibleBundledTxWithNoSigner(t *testing.T) {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+
+	bid := bidTx{
+		id: "bid",
+		bid: types.NewMsgAuctionBid(bidder, sdk.NewInt64Coin("uatom", 10), types.NewBundle(
+			types.BundleTx{Raw: []byte("bundled-tx"), Revertible: true},
+		)),
+	}
+
+	cfg := newTestConfig()
+	cfg.SignerExtractor = failingSignerExtractor{}
+
+	mempool := &fakeMempool{txs: []sdk.Tx{bid}}
+	lane := mev.NewLane(cfg, mempool)
+
+	proposal := blockbuster.NewProposal(1024)
+	proposal.LaneLimits[lane.Name()] = math.LegacyOneDec()
+
+	txsToInclude, txsToExclude, err := lane.PrepareLaneHandler()(sdk.Context{}, proposal)
+	require.NoError(t, err)
+	require.Empty(t, txsToExclude)
+	require.Equal(t, [][]byte{[]byte("bid")}, txsToInclude,
+		"a revertible bundled tx whose signer can't be derived should be dropped, not reject the whole bid")
+}
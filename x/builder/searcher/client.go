@@ -0,0 +1,45 @@
+package searcher
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	submitBidMethod   = "/pob.builder.v1.Searcher/SubmitBid"
+	simulateBidMethod = "/pob.builder.v1.Searcher/SimulateBid"
+)
+
+// Client is a thin wrapper around a gRPC connection to the Searcher
+// service, so that bots can submit and simulate bids from Go code without
+// shelling out to the CLI.
+type Client struct {
+	conn *grpc.ClientConn
+}
+
+// NewClient returns a new searcher Client using conn.
+func NewClient(conn *grpc.ClientConn) *Client {
+	return &Client{conn: conn}
+}
+
+// SubmitBid submits req to the node's Searcher service.
+func (c *Client) SubmitBid(ctx context.Context, req *BidRequest) (*BidResponse, error) {
+	resp := new(BidResponse)
+	if err := c.conn.Invoke(ctx, submitBidMethod, req, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// SimulateBid simulates req against the node's Searcher service without
+// submitting it.
+func (c *Client) SimulateBid(ctx context.Context, req *BidRequest) (*BidResponse, error) {
+	resp := new(BidResponse)
+	if err := c.conn.Invoke(ctx, simulateBidMethod, req, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
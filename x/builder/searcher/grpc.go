@@ -0,0 +1,77 @@
+package searcher
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// SearcherServer is the server API for the Searcher gRPC service described
+// in proto/pob/builder/v1/searcher.proto. Server implements it.
+type SearcherServer interface {
+	SubmitBid(context.Context, *BidRequest) (*BidResponse, error)
+	SimulateBid(context.Context, *BidRequest) (*BidResponse, error)
+}
+
+// RegisterSearcherServer registers srv as the implementation of the
+// Searcher gRPC service on s, so that requests to the methods Client calls
+// (submitBidMethod, simulateBidMethod) reach it. Call this alongside the
+// node's other services wherever its grpc.Server is assembled (e.g. an
+// application's RegisterServices, next to cfg.RegisterService calls for
+// the node's other modules).
+func RegisterSearcherServer(s *grpc.Server, srv SearcherServer) {
+	s.RegisterService(&searcherServiceDesc, srv)
+}
+
+var searcherServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pob.builder.v1.Searcher",
+	HandlerType: (*SearcherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitBid",
+			Handler:    submitBidHandler,
+		},
+		{
+			MethodName: "SimulateBid",
+			Handler:    simulateBidHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pob/builder/v1/searcher.proto",
+}
+
+func submitBidHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(SearcherServer).SubmitBid(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: submitBidMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearcherServer).SubmitBid(ctx, req.(*BidRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
+
+func simulateBidHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+
+	if interceptor == nil {
+		return srv.(SearcherServer).SimulateBid(ctx, in)
+	}
+
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: simulateBidMethod}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearcherServer).SimulateBid(ctx, req.(*BidRequest))
+	}
+
+	return interceptor(ctx, in, info, handler)
+}
@@ -0,0 +1,150 @@
+package searcher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/skip-mev/pob/blockbuster"
+	"github.com/skip-mev/pob/x/builder/lanes/mev"
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+// ContextProvider returns the node's current check-tx context. Server calls
+// it fresh for every request so that a long-running process never validates
+// a bid against state that was current only when the Server was built.
+type ContextProvider func() sdk.Context
+
+// Server implements the Searcher gRPC service. It runs the auction ante
+// checks for a bid against a cached context, and on SubmitBid hands a
+// passing bid to the MEV lane's mempool exactly as if it had arrived via
+// CheckTx.
+type Server struct {
+	ctxProvider ContextProvider
+	mevLane     blockbuster.Lane
+	txDecoder   sdk.TxDecoder
+}
+
+// NewServer returns a new searcher Server. ctxProvider should return the
+// node's current check-tx context; Server caches it for every request so
+// that simulation never mutates node state.
+func NewServer(ctxProvider ContextProvider, mevLane blockbuster.Lane, txDecoder sdk.TxDecoder) *Server {
+	return &Server{
+		ctxProvider: ctxProvider,
+		mevLane:     mevLane,
+		txDecoder:   txDecoder,
+	}
+}
+
+// SubmitBid implements the Searcher service. A bid that passes validation is
+// inserted into the MEV lane's mempool.
+func (s *Server) SubmitBid(ctx context.Context, req *BidRequest) (*BidResponse, error) {
+	bidTx, resp, err := s.validate(req)
+	if err != nil || !resp.Accepted {
+		return resp, err
+	}
+
+	if err := s.mevLane.Insert(ctx, bidTx); err != nil {
+		return nil, fmt.Errorf("failed to insert bid into mempool: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SimulateBid implements the Searcher service. It runs the same validation
+// as SubmitBid, but never touches the mempool.
+func (s *Server) SimulateBid(_ context.Context, req *BidRequest) (*BidResponse, error) {
+	_, resp, err := s.validate(req)
+	return resp, err
+}
+
+// validate decodes and verifies the bid transaction and every bundled
+// transaction against a cached context, and computes the bid's simulated
+// priority/position against the lane's current top-of-block mempool.
+func (s *Server) validate(req *BidRequest) (sdk.Tx, *BidResponse, error) {
+	cacheCtx, _ := s.ctxProvider().CacheContext()
+
+	bidTx, err := s.txDecoder(req.BidTx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode bid transaction: %w", err)
+	}
+
+	bid, err := mev.GetMsgAuctionBid(bidTx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("bid transaction does not contain a MsgAuctionBid: %w", err)
+	}
+
+	if !bundleMatches(bid, req.BundledTxs) {
+		return nil, nil, fmt.Errorf("bundled_txs does not match the bundle carried by the bid transaction")
+	}
+
+	resp := &BidResponse{Accepted: true}
+
+	for i, rawTx := range req.BundledTxs {
+		bundledTx, err := s.txDecoder(rawTx)
+		if err != nil {
+			resp.Accepted = false
+			resp.BundleErrors = append(resp.BundleErrors, BundleTxError{Index: uint64(i), Error: err.Error()})
+			continue
+		}
+
+		if err := s.mevLane.VerifyTx(cacheCtx, bundledTx); err != nil {
+			resp.Accepted = false
+			resp.BundleErrors = append(resp.BundleErrors, BundleTxError{Index: uint64(i), Error: err.Error()})
+		}
+	}
+
+	if !resp.Accepted {
+		return bidTx, resp, nil
+	}
+
+	if err := s.mevLane.VerifyTx(cacheCtx, bidTx); err != nil {
+		return nil, nil, fmt.Errorf("bid transaction failed verification: %w", err)
+	}
+
+	priority, position := s.simulateOrdering(cacheCtx, bid)
+	resp.Priority = priority
+	resp.Position = position
+
+	return bidTx, resp, nil
+}
+
+// bundleMatches reports whether bundledTxs - the bundle a caller submitted
+// for validation - is byte-for-byte equal, in order, to the bundle the
+// signed bid transaction itself carries. Without this check a searcher
+// could submit a benign bundledTxs purely to win an Accepted response while
+// bidTx carries a different, unvalidated bundle.
+func bundleMatches(bid *types.MsgAuctionBid, bundledTxs [][]byte) bool {
+	rawTxs := bid.Bundle.RawTxs()
+	if len(rawTxs) != len(bundledTxs) {
+		return false
+	}
+
+	for i, rawTx := range rawTxs {
+		if !bytes.Equal(rawTx, bundledTxs[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// simulateOrdering reports where bid would land against the bids currently
+// sitting in the MEV lane's mempool: its priority (the bid amount) and its
+// 0-indexed position (how many currently-mempooled bids out-bid it).
+func (s *Server) simulateOrdering(ctx sdk.Context, bid *types.MsgAuctionBid) (priority int64, position uint64) {
+	priority = bid.Bid.Amount.Int64()
+
+	iterator := s.mevLane.Select(ctx, nil)
+	for iterator != nil {
+		existingBid, err := mev.GetMsgAuctionBid(iterator.Tx())
+		if err == nil && existingBid.Bid.Denom == bid.Bid.Denom && existingBid.Bid.IsGTE(bid.Bid) {
+			position++
+		}
+
+		iterator = iterator.Next()
+	}
+
+	return priority, position
+}
@@ -0,0 +1,37 @@
+package searcher
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func newTestBid(rawTxs ...[]byte) *types.MsgAuctionBid {
+	bidder := sdk.AccAddress([]byte("bidder______________"))
+	return types.NewMsgAuctionBid(bidder, sdk.NewInt64Coin("uatom", 100), types.NewLegacyBundle(rawTxs))
+}
+
+func TestBundleMatches(t *testing.T) {
+	t.Run("true when bundledTxs is byte-for-byte equal to the bid's bundle", func(t *testing.T) {
+		bid := newTestBid([]byte("one"), []byte("two"))
+		require.True(t, bundleMatches(bid, [][]byte{[]byte("one"), []byte("two")}))
+	})
+
+	t.Run("false when bundledTxs omits a transaction the bid carries", func(t *testing.T) {
+		bid := newTestBid([]byte("one"), []byte("two"))
+		require.False(t, bundleMatches(bid, [][]byte{[]byte("one")}))
+	})
+
+	t.Run("false when bundledTxs reorders the bid's transactions", func(t *testing.T) {
+		bid := newTestBid([]byte("one"), []byte("two"))
+		require.False(t, bundleMatches(bid, [][]byte{[]byte("two"), []byte("one")}))
+	})
+
+	t.Run("false when bundledTxs substitutes a different transaction", func(t *testing.T) {
+		bid := newTestBid([]byte("one"), []byte("two"))
+		require.False(t, bundleMatches(bid, [][]byte{[]byte("one"), []byte("evil")}))
+	})
+}
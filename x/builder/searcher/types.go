@@ -0,0 +1,33 @@
+package searcher
+
+import "fmt"
+
+// BidRequest carries a raw, signed MsgAuctionBid transaction along with the
+// raw transactions it bundles. It mirrors proto/pob/builder/v1/searcher.proto.
+type BidRequest struct {
+	BidTx      []byte   `json:"bid_tx" protobuf:"bytes,1,opt,name=bid_tx,json=bidTx,proto3"`
+	BundledTxs [][]byte `json:"bundled_txs" protobuf:"bytes,2,rep,name=bundled_txs,json=bundledTxs,proto3"`
+}
+
+func (m *BidRequest) Reset()         { *m = BidRequest{} }
+func (m *BidRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*BidRequest) ProtoMessage()    {}
+
+// BidResponse reports the outcome of validating (and, for SubmitBid,
+// accepting) a bid.
+type BidResponse struct {
+	Accepted     bool            `json:"accepted" protobuf:"varint,1,opt,name=accepted,proto3"`
+	BundleErrors []BundleTxError `json:"bundle_errors,omitempty" protobuf:"bytes,2,rep,name=bundle_errors,json=bundleErrors,proto3"`
+	Priority     int64           `json:"priority,omitempty" protobuf:"varint,3,opt,name=priority,proto3"`
+	Position     uint64          `json:"position,omitempty" protobuf:"varint,4,opt,name=position,proto3"`
+}
+
+func (m *BidResponse) Reset()         { *m = BidResponse{} }
+func (m *BidResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*BidResponse) ProtoMessage()    {}
+
+// BundleTxError reports a single bundled transaction's validation failure.
+type BundleTxError struct {
+	Index uint64 `json:"index" protobuf:"varint,1,opt,name=index,proto3"`
+	Error string `json:"error" protobuf:"bytes,2,opt,name=error,proto3"`
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BundleVersion identifies the wire format of a Bundle.
+type BundleVersion uint32
+
+const (
+	// BundleVersionLegacy is the version of a Bundle built from the original
+	// flat []byte list MsgAuctionBid.Transactions used to carry: every tx is
+	// non-revertible and carries no hints. It is accepted for one release
+	// after BundleVersionCurrent's introduction.
+	BundleVersionLegacy BundleVersion = 0
+
+	// BundleVersionCurrent is the Bundle version new clients should produce.
+	BundleVersionCurrent BundleVersion = 1
+)
+
+// Bundle is a versioned, ordered list of transactions a MsgAuctionBid bids
+// to have included immediately after itself. It mirrors
+// proto/pob/builder/v1/tx.proto.
+type Bundle struct {
+	Version BundleVersion `json:"version" protobuf:"varint,1,opt,name=version,proto3,casttype=BundleVersion"`
+	Txs     []BundleTx    `json:"txs" protobuf:"bytes,2,rep,name=txs,proto3"`
+}
+
+func (m *Bundle) Reset()         { *m = Bundle{} }
+func (m *Bundle) String() string { return fmt.Sprintf("%+v", *m) }
+func (*Bundle) ProtoMessage()    {}
+
+// BundleTx is a single transaction within a Bundle.
+type BundleTx struct {
+	Raw        []byte      `json:"raw" protobuf:"bytes,1,opt,name=raw,proto3"`
+	Revertible bool        `json:"revertible" protobuf:"varint,2,opt,name=revertible,proto3"`
+	Hints      BundleHints `json:"hints" protobuf:"bytes,3,opt,name=hints,proto3"`
+}
+
+func (m *BundleTx) Reset()         { *m = BundleTx{} }
+func (m *BundleTx) String() string { return fmt.Sprintf("%+v", *m) }
+func (*BundleTx) ProtoMessage()    {}
+
+// BundleHints carries a searcher's claims about a BundleTx (its signer and
+// sequence, and whether it is simulation-only), so that a validator which
+// trusts the searcher enough to skip re-deriving them
+// (e.g. because the tx already passed the searcher gRPC service's own
+// validation) doesn't have to.
+type BundleHints struct {
+	ExpectedSigner   string `json:"expected_signer,omitempty" protobuf:"bytes,1,opt,name=expected_signer,json=expectedSigner,proto3"`
+	ExpectedSequence uint64 `json:"expected_sequence,omitempty" protobuf:"varint,2,opt,name=expected_sequence,json=expectedSequence,proto3"`
+	SimulationOnly   bool   `json:"simulation_only,omitempty" protobuf:"varint,3,opt,name=simulation_only,json=simulationOnly,proto3"`
+}
+
+func (m *BundleHints) Reset()         { *m = BundleHints{} }
+func (m *BundleHints) String() string { return fmt.Sprintf("%+v", *m) }
+func (*BundleHints) ProtoMessage()    {}
+
+// NewBundle returns a new BundleVersionCurrent Bundle containing txs.
+func NewBundle(txs ...BundleTx) Bundle {
+	return Bundle{
+		Version: BundleVersionCurrent,
+		Txs:     txs,
+	}
+}
+
+// NewLegacyBundle wraps rawTxs, a flat ordered list of raw transactions, in
+// a BundleVersionLegacy Bundle - the v0 shim for clients that have not
+// migrated to constructing a Bundle directly. Every tx is non-revertible and
+// carries no hints, matching the behavior MsgAuctionBid.Transactions had
+// before Bundle was introduced.
+func NewLegacyBundle(rawTxs [][]byte) Bundle {
+	txs := make([]BundleTx, len(rawTxs))
+	for i, raw := range rawTxs {
+		txs[i] = BundleTx{Raw: raw}
+	}
+
+	return Bundle{
+		Version: BundleVersionLegacy,
+		Txs:     txs,
+	}
+}
+
+// RawTxs returns the bundle's transactions as a flat, ordered list of raw
+// bytes, discarding per-tx metadata.
+func (b Bundle) RawTxs() [][]byte {
+	raw := make([][]byte, len(b.Txs))
+	for i, tx := range b.Txs {
+		raw[i] = tx.Raw
+	}
+
+	return raw
+}
+
+// ValidateBasic performs stateless validation of a Bundle.
+func (b Bundle) ValidateBasic() error {
+	if len(b.Txs) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bundle must contain at least one transaction")
+	}
+
+	for i, tx := range b.Txs {
+		if len(tx.Raw) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("bundled transaction %d is empty", i)
+		}
+	}
+
+	return nil
+}
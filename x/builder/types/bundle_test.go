@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/pob/x/builder/types"
+)
+
+func TestBundleValidateBasic(t *testing.T) {
+	t.Run("rejects an empty bundle", func(t *testing.T) {
+		bundle := types.NewBundle()
+		require.Error(t, bundle.ValidateBasic())
+	})
+
+	t.Run("rejects a bundle containing an empty transaction", func(t *testing.T) {
+		bundle := types.NewBundle(types.BundleTx{Raw: []byte("tx")}, types.BundleTx{})
+		require.Error(t, bundle.ValidateBasic())
+	})
+
+	t.Run("accepts a bundle of non-empty transactions", func(t *testing.T) {
+		bundle := types.NewBundle(types.BundleTx{Raw: []byte("tx1")}, types.BundleTx{Raw: []byte("tx2")})
+		require.NoError(t, bundle.ValidateBasic())
+	})
+}
+
+func TestNewLegacyBundle(t *testing.T) {
+	bundle := types.NewLegacyBundle([][]byte{[]byte("tx1"), []byte("tx2")})
+
+	require.Equal(t, types.BundleVersionLegacy, bundle.Version)
+	require.Equal(t, [][]byte{[]byte("tx1"), []byte("tx2")}, bundle.RawTxs())
+
+	for _, tx := range bundle.Txs {
+		require.False(t, tx.Revertible)
+		require.Zero(t, tx.Hints)
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+const (
+	// ModuleName is the name of the builder module.
+	ModuleName = "builder"
+
+	// StoreKey is the store key string for the builder module.
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the builder module.
+	RouterKey = ModuleName
+)
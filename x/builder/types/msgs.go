@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgAuctionBid defines a bid submitted by a searcher for the top-of-block
+// auction. Bidder is the address that pays Bid, and Bundle is the ordered,
+// versioned bundle of transactions the bidder wants included immediately
+// after the bid transaction itself if it wins the auction.
+type MsgAuctionBid struct {
+	Bidder string   `json:"bidder" protobuf:"bytes,1,opt,name=bidder,proto3"`
+	Bid    sdk.Coin `json:"bid" protobuf:"bytes,2,opt,name=bid,proto3"`
+	Bundle Bundle   `json:"bundle" protobuf:"bytes,3,opt,name=bundle,proto3"`
+}
+
+func (m *MsgAuctionBid) Reset()         { *m = MsgAuctionBid{} }
+func (m *MsgAuctionBid) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgAuctionBid) ProtoMessage()    {}
+
+// NewMsgAuctionBid returns a new MsgAuctionBid. Use NewLegacyBundle to build
+// bundle from a flat list of raw transactions if the caller has not
+// migrated to constructing a Bundle directly.
+func NewMsgAuctionBid(bidder sdk.AccAddress, bid sdk.Coin, bundle Bundle) *MsgAuctionBid {
+	return &MsgAuctionBid{
+		Bidder: bidder.String(),
+		Bid:    bid,
+		Bundle: bundle,
+	}
+}
+
+// ValidateBasic performs stateless validation of a MsgAuctionBid.
+func (msg *MsgAuctionBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Bidder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid bidder address: %s", err)
+	}
+
+	if !msg.Bid.IsValid() || msg.Bid.IsZero() {
+		return sdkerrors.ErrInvalidRequest.Wrap("bid must be a positive, valid coin")
+	}
+
+	if msg.Bundle.Version > BundleVersionCurrent {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unsupported bundle version %d", msg.Bundle.Version)
+	}
+
+	if err := msg.Bundle.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSigners returns the bidder as the only required signer of a
+// MsgAuctionBid.
+func (msg *MsgAuctionBid) GetSigners() []sdk.AccAddress {
+	bidder, err := sdk.AccAddressFromBech32(msg.Bidder)
+	if err != nil {
+		panic(fmt.Errorf("invalid bidder address in MsgAuctionBid: %w", err))
+	}
+
+	return []sdk.AccAddress{bidder}
+}